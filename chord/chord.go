@@ -14,6 +14,7 @@ package chord
 
 import (
 	"strings"
+	"unicode"
 
 	"github.com/go-music-theory/music-theory/note"
 )
@@ -33,6 +34,15 @@ func Of(name string) Chord {
 	return c
 }
 
+// Parse a chord strictly, e.g. Parse("Cm7b5/Gb"), returning a *note.ParseError if the root, accidental, form or slash bass is not understood
+func Parse(name string) (Chord, error) {
+	c := Chord{}
+	if err := c.parse(name); err != nil {
+		return Chord{}, err
+	}
+	return c, nil
+}
+
 // Notes to obtain the notes from the Chord
 func (this *Chord) Notes() (notes []*note.Note) {
 	// If there's a bass note (slash chord), add it first
@@ -83,32 +93,99 @@ func (this Chord) Transpose(semitones int) Chord {
 	return transposedChord
 }
 
+// Spelled tones of the Chord, in which each tone uses the letter name of its interval from the root, e.g. C#7 is C# E# G# B
+// Microtonal tones (e.g. a harmonic seventh) cannot be spelled, and are omitted.
+func (this Chord) Spelled() map[Interval]note.Spelled {
+	spelled := make(map[Interval]note.Spelled)
+	root := this.Root.Spelled(this.AdjSymbol)
+	for i, class := range this.Tones {
+		if class.IsChromatic() {
+			spelled[i] = class.SpelledAs(root.Letter.Step(int(i) - 1))
+		}
+	}
+	return spelled
+}
+
+// Intervals of each tone above the root of the Chord, e.g. Cm7 is P1 m3 P5 m7, and the ninth of C9 is a major ninth (M9)
+// Microtonal tones (e.g. a harmonic seventh) have no such interval, and are omitted.
+func (this Chord) Intervals() map[Interval]note.Interval {
+	intervals := make(map[Interval]note.Interval)
+	root := this.Root.Spelled(this.AdjSymbol)
+	for i, s := range this.Spelled() {
+		interval := note.IntervalBetween(root, s)
+		interval.Number += 7 * ((int(i) - 1) / 7)
+		intervals[i] = interval
+	}
+	return intervals
+}
+
 //
 // Private
 //
 
-func (this *Chord) parse(name string) {
+// parse the chord from its name, building as much of the chord as possible, and returning a *note.ParseError for the first part of the name that is not understood.
+func (this *Chord) parse(name string) (err error) {
 	this.Tones = make(map[Interval]note.Class)
 	this.Bass = note.Nil // Initialize bass note as Nil
+	fullName := name
 
 	// determine whether the name is "sharps" or "flats"
 	this.AdjSymbol = note.AdjSymbolOf(name)
 
-	// Check for slash chord notation (e.g., "C/E" or "Cmaj7/B")
-	slashIndex := strings.Index(name, "/")
+	// Check for slash chord notation (e.g., "C/E" or "Cmaj7/B"), where a slash before a digit is part of the form (e.g., "C6/9")
+	slashIndex := slashBassIndex(name)
 
+	var bassErr *note.ParseError
 	if slashIndex != -1 {
 		// Parse bass note from slash notation
 		bassString := name[slashIndex+1:]
 		this.Bass, _ = note.RootAndRemaining(bassString)
+		if bassErr = parseBass(bassString); bassErr != nil {
+			bassErr = bassErr.Within(fullName, slashIndex+1)
+		}
 
 		// Parse the chord part before the slash
 		name = name[:slashIndex]
 	}
 
 	// parse the root, and keep the remaining string
+	_, formOffset, rootErr := note.ParseRoot(name)
 	this.Root, name = note.RootAndRemaining(name)
 
 	// parse the chord Form
 	this.parseForms(name)
+
+	// report the first part of the name that is not understood
+	if rootErr != nil {
+		return rootErr.(*note.ParseError).Within(fullName, 0)
+	}
+	if offset := unknownFormOffset(name); offset >= 0 {
+		return &note.ParseError{Name: fullName, Part: note.FormPart, Offset: formOffset + offset}
+	}
+	if bassErr != nil {
+		return bassErr
+	}
+	return nil
+}
+
+// slashBassIndex of the slash before the bass note of a slash chord, skipping any slash before a digit, which is part of the form, e.g. 6/9, or -1 if there is none
+func slashBassIndex(name string) int {
+	for i := 0; i < len(name); i++ {
+		if name[i] == '/' && (i+1 == len(name) || !unicode.IsDigit(rune(name[i+1]))) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseBass of a slash chord strictly, which must be a single note with no form or octave
+func parseBass(name string) *note.ParseError {
+	_, end, err := note.ParseRoot(name)
+	if err != nil {
+		return &note.ParseError{Name: name, Part: note.BassPart, Offset: err.(*note.ParseError).Offset}
+	}
+	if end < len(strings.TrimRightFunc(name, unicode.IsSpace)) {
+		return &note.ParseError{Name: name, Part: note.BassPart, Offset: end}
+	}
+	return nil
 }
@@ -9,7 +9,6 @@ import (
 	"gopkg.in/stretchr/testify.v1/assert"
 	"gopkg.in/yaml.v2"
 
-	"github.com/go-music-theory/music-theory/key"
 	"github.com/go-music-theory/music-theory/note"
 )
 
@@ -44,9 +43,86 @@ func TestNotes(t *testing.T) {
 	}, c.Notes())
 }
 
+func TestParse(t *testing.T) {
+	c, err := Parse("Cm7b5/Gb")
+	assert.Nil(t, err)
+	assert.Equal(t, Of("Cm7b5/Gb"), c)
+
+	// a slash before a digit is part of the form, e.g. 6/9
+	c, err = Parse("C6/9")
+	assert.Nil(t, err)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.E, I5: note.G, I6: note.A, I9: note.D}, c.Tones)
+	assert.Equal(t, note.Nil, c.Bass)
+	c, err = Parse("Cm6/9")
+	assert.Nil(t, err)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.Ds, I5: note.G, I6: note.A, I9: note.D}, c.Tones)
+	c, err = Parse("C6/9/E")
+	assert.Nil(t, err)
+	assert.Equal(t, note.E, c.Bass)
+
+	testExpectations := testExpectationManifest{}
+	file, err := ioutil.ReadFile("testdata/expectations.yaml")
+	assert.Nil(t, err)
+	err = yaml.Unmarshal(file, &testExpectations)
+	assert.Nil(t, err)
+	for name := range testExpectations.Chords {
+		_, err := Parse(name)
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	assertParseError(t, "Xyz", note.RootPart, 0)
+	assertParseError(t, "", note.RootPart, 0)
+	assertParseError(t, "C#♯m", note.AccidentalPart, 2)
+	assertParseError(t, "Cfoo", note.FormPart, 1)
+	assertParseError(t, "C maj 8", note.FormPart, 6)
+	assertParseError(t, "Cm8", note.FormPart, 2)
+	assertParseError(t, "Cm7/X", note.BassPart, 4)
+	assertParseError(t, "C/", note.BassPart, 2)
+	assertParseError(t, "C/Eb7", note.BassPart, 4)
+	assertParseError(t, "C6/9x", note.FormPart, 4)
+	assertParseError(t, "C6/9/Q", note.BassPart, 5)
+}
+
+func TestSpelled(t *testing.T) {
+	assert.Equal(t, map[Interval]note.Spelled{
+		I1: note.SpelledNamed("C#"),
+		I3: note.SpelledNamed("E#"),
+		I5: note.SpelledNamed("G#"),
+		I7: note.SpelledNamed("B"),
+	}, Of("C#7").Spelled())
+
+	assert.Equal(t, map[Interval]note.Spelled{
+		I1: note.SpelledNamed("Gb"),
+		I3: note.SpelledNamed("Bbb"),
+		I5: note.SpelledNamed("Dbb"),
+		I7: note.SpelledNamed("Fbb"),
+	}, Of("Gbdim7").Spelled())
+
+	// harmonic seventh cannot be spelled
+	assert.Equal(t, map[Interval]note.Spelled{
+		I1: note.SpelledNamed("C"),
+		I3: note.SpelledNamed("E"),
+		I5: note.SpelledNamed("G"),
+	}, Of("C harmonic 7").Spelled())
+}
+
+func TestIntervals(t *testing.T) {
+	assert.Equal(t, map[Interval]note.Interval{
+		I1: {Quality: note.Perfect, Number: 1},
+		I3: {Quality: note.Minor, Number: 3},
+		I5: {Quality: note.Diminished, Number: 5},
+		I7: {Quality: note.Minor, Number: 7},
+	}, Of("Cm7b5").Intervals())
+
+	assert.Equal(t, note.Interval{Quality: note.Major, Number: 9}, Of("C9").Intervals()[I9])
+	assert.Equal(t, note.Interval{Quality: note.Perfect, Number: 11}, Of("C11").Intervals()[I11])
+}
+
 func TestOf_Invalid(t *testing.T) {
-	k := key.Of("P-funk")
-	assert.Equal(t, note.Nil, k.Root)
+	c := Of("P-funk")
+	assert.Equal(t, note.Nil, c.Root)
 }
 
 func TestTranspose(t *testing.T) {
@@ -235,6 +311,12 @@ func TestSlashChordWithoutBass(t *testing.T) {
 // Private
 //
 
+func assertParseError(t *testing.T, name string, expectPart note.NamePart, expectOffset int) {
+	c, err := Parse(name)
+	assert.Equal(t, &note.ParseError{Name: name, Part: expectPart, Offset: expectOffset}, err, name)
+	assert.Equal(t, Chord{}, c)
+}
+
 type testKey struct {
 	Root  string
 	Tones map[Interval]string
@@ -103,3 +103,63 @@ func ExampleChord_Transpose_slashChord() {
 	// Original: C/E
 	// Transposed: D/F#
 }
+
+// ExampleParse demonstrates strictly parsing a chord, rejecting names that are not understood
+func ExampleParse() {
+	c, err := chord.Parse("Cm7b5/Gb")
+	fmt.Printf("%s/%s %v\n", c.Root.String(c.AdjSymbol), c.Bass.String(c.AdjSymbol), err)
+
+	_, err = chord.Parse("Cm7/X")
+	fmt.Println(err)
+
+	// Output:
+	// C/Gb <nil>
+	// cannot parse bass at offset 4 of "Cm7/X"
+}
+
+// ExampleChord_Name demonstrates naming a chord by its conventional symbol, in various styles
+func ExampleChord_Name() {
+	c := chord.Of("C minor 7 flat 5/Gb")
+	fmt.Println(c.Name())
+	fmt.Println(c.NameWith(chord.JazzStyle))
+	fmt.Println(c.NameWith(chord.SymbolStyle))
+
+	// Output:
+	// Cm7b5/Gb
+	// Cmi7(b5)/Gb
+	// Cø7/Gb
+}
+
+// ExampleChord_Voice demonstrates voicing a chord into notes with octaves
+func ExampleChord_Voice() {
+	c := chord.Of("Cmaj7")
+	for _, technique := range []chord.Technique{chord.BlockTechnique, chord.Drop2Technique, chord.SpreadTechnique} {
+		notes, _ := c.Voice(chord.Voicing{Technique: technique})
+		for i, n := range notes {
+			if i > 0 {
+				fmt.Printf(" ")
+			}
+			fmt.Printf("%s%d", n.Class.String(c.AdjSymbol), n.Octave)
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// C4 E4 G4 B4
+	// C4 G4 B4 E5
+	// C3 E4 G4 B4
+}
+
+// ExampleChord_LeadFrom demonstrates leading the voices of one chord smoothly to the next
+func ExampleChord_LeadFrom() {
+	previous, _ := chord.Of("C").Voice(chord.Voicing{})
+	next, motions, _ := chord.Of("G7").LeadFrom(previous, chord.VoiceLeading{})
+	for i, m := range motions {
+		fmt.Printf("%s%d -> %s%d (%+d)\n", m.From.Class.String(note.Sharp), m.From.Octave, next[i].Class.String(note.Sharp), next[i].Octave, m.Semitones)
+	}
+
+	// Output:
+	// C4 -> B3 (-1)
+	// E4 -> F4 (+1)
+	// G4 -> G4 (+0)
+}
@@ -4,6 +4,8 @@ package chord
 import (
 	//"log"
 	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/go-music-theory/music-theory/note"
 )
@@ -41,8 +43,8 @@ var nExp = "[. ]*"
 
 // Regular expressions for different utilities
 var (
-	majorExp = "(M|maj|major|Δ)"
-	minorExp = "([^a-z]|^)(m|min|minor|−)"
+	majorExp = "(M|ma|maj|major|Δ)"
+	minorExp = "([^a-z]|^)(m|mi|min|minor|−)"
 
 	flatExp  = "(f|flat|b|♭)"
 	sharpExp = "(#|s|sharp)"
@@ -69,6 +71,13 @@ var (
 	flatSeventhPattern = regexp.MustCompile(`(b|♭)\s*7`)
 )
 
+// Regular expressions for strictly parsing the words and numbers of a form name
+var (
+	formTokenExp  = regexp.MustCompile(`\pL+|[0-9]+`)
+	fillerWordExp = regexp.MustCompile(`^(add|st|nd|rd|th)$`)
+	formMarkExps  = formMarkExpsOf(forms)
+)
+
 // Common FormAdd for altered dominant chords
 var alteredDominantFormAdd = FormAdd{
 	I3:  4,  // major 3rd
@@ -141,7 +150,7 @@ var forms = []Form{
 
 	Form{
 		Name: "Suspended Triad",
-		pos:  exp("^" + suspendedExp),
+		pos:  exp(suspendedExp + "4?"),
 		add: FormAdd{
 			I4: 5, // 4th
 			I5: 7, // perfect 5th
@@ -728,6 +737,50 @@ func (this *Form) matchPosNegString(s string) bool {
 	}
 }
 
+// unknownFormOffset is the byte offset of the first word or number in the given form name that is not matched by any Form or interval modifier, or -1 if the whole name is understood.
+// A word is understood if any part of it is matched (e.g. "non" of "nondominant"), whereas every digit of a number must be matched (e.g. "679" but not "8").
+func unknownFormOffset(name string) int {
+	matched := make([]bool, len(name))
+	markMatched := func(r *regexp.Regexp) {
+		for _, loc := range r.FindAllStringIndex(name, -1) {
+			for i := loc[0]; i < loc[1]; i++ {
+				matched[i] = true
+			}
+		}
+	}
+	for _, r := range formMarkExps {
+		markMatched(r)
+	}
+	markMatched(sharpIntervalExp)
+	markMatched(flatIntervalExp)
+
+	for _, loc := range formTokenExp.FindAllStringIndex(name, -1) {
+		anyMatched, allMatched := false, true
+		for i := loc[0]; i < loc[1]; i++ {
+			anyMatched = anyMatched || matched[i]
+			allMatched = allMatched && matched[i]
+		}
+		if fillerWordExp.MatchString(name[loc[0]:loc[1]]) {
+			continue
+		}
+		if unicode.IsDigit(rune(name[loc[0]])) && !allMatched || !anyMatched {
+			return loc[0]
+		}
+	}
+	return -1
+}
+
+// formMarkExpsOf the forms, the expression of each form without the delimiter before or after a word, e.g. the 8 after the m of Cm8, so that only the characters of the form itself are matched
+func formMarkExpsOf(forms []Form) (exps []*regexp.Regexp) {
+	delimiters := strings.NewReplacer("([^a-z]|$)", "", "([^a-z]|^)", "")
+	for _, f := range forms {
+		if f.pos != nil {
+			exps = append(exps, regexp.MustCompile(delimiters.Replace(f.pos.String())))
+		}
+	}
+	return
+}
+
 // Build the chord by processing all Forms against the given name.
 func (this *Chord) parseForms(name string) {
 	var toDelete []Interval
@@ -26,6 +26,30 @@ func TestChordParseForms(t *testing.T) {
 	}, c.Tones)
 }
 
+func TestChordParseForms_Abbreviations(t *testing.T) {
+	// "mi" and "ma" are minor and major, as written in the jazz style
+	assert.Equal(t, Of("Cm").Tones, Of("Cmi").Tones)
+	assert.Equal(t, Of("Cm7").Tones, Of("Cmi7").Tones)
+	assert.Equal(t, Of("Cm7").Tones, Of("C mi 7").Tones)
+	assert.Equal(t, Of("Cmaj7").Tones, Of("Cma7").Tones)
+	assert.Equal(t, Of("Cmaj9").Tones, Of("Cma9").Tones)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.Ds, I5: note.G, I7: note.B}, Of("Cm(ma7)").Tones)
+	// the longer names are unchanged
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.Ds, I5: note.G}, Of("Cmin").Tones)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I3: note.E, I5: note.G, I7: note.B}, Of("Cmaj7").Tones)
+}
+
+func TestChordParseForms_Suspended(t *testing.T) {
+	sus := map[Interval]note.Class{I1: note.C, I4: note.F, I5: note.G}
+	assert.Equal(t, sus, Of("Csus").Tones)
+	assert.Equal(t, sus, Of("Csus4").Tones)
+	assert.Equal(t, sus, Of("C sus").Tones)
+	// suspended after a seventh or extension, in place of the third
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I4: note.F, I5: note.G, I7: note.As}, Of("C7sus4").Tones)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I4: note.F, I5: note.G, I7: note.As}, Of("C7sus").Tones)
+	assert.Equal(t, map[Interval]note.Class{I1: note.C, I4: note.F, I5: note.G, I7: note.As, I9: note.D}, Of("C9sus4").Tones)
+}
+
 //
 // Private
 //
@@ -0,0 +1,148 @@
+// Chords can be identified from a set of pitch classes, e.g. {E, G, C} is C/E, using the known chord forms as the vocabulary.
+package chord
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Candidate Chord identified from a set of pitch classes, with a Confidence from 0 (no match) to 1 (exact match in root position)
+type Candidate struct {
+	Chord      Chord
+	Confidence float64
+}
+
+// Identify the chords that a set of pitch classes might be, ranked by confidence, most likely first.
+// The first class is taken to be the bass, so {E, G, C} is identified first as C/E; ambiguous sets such as {C, E, G, A} yield multiple candidates, e.g. C6 and Am7/C.
+// Each candidate has a root among the given classes, and the slash bass is set wherever the bass is not the root.
+func Identify(classes []note.Class) (candidates []Candidate) {
+	bass, distinct := distinctClasses(classes)
+	if len(distinct) == 0 {
+		return
+	}
+
+	for _, root := range distinct {
+		heard := toneSetOfClasses(root, distinct)
+		for _, template := range templates {
+			confidence := similarity(heard, template.tones)
+			if confidence < minIdentifyConfidence {
+				continue
+			}
+			c := chordOfForms(root, identifyAdjSymbolOf(root), template.forms...)
+			if root != bass {
+				c.Bass = bass
+				confidence *= slashConfidence
+			}
+			candidates = append(candidates, Candidate{
+				Chord:      c,
+				Confidence: confidence,
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return
+}
+
+//
+// Private
+//
+
+// Candidates less similar than this are not considered
+const minIdentifyConfidence = 0.6
+
+// A slash chord is slightly less likely than the same chord in root position
+const slashConfidence = 0.9
+
+// template of the tones of a chord, as the forms which are applied to the Basic form to build it
+type template struct {
+	forms []Form
+	tones toneSet
+}
+
+// templates of every chord that can be built from the Basic form plus one or two other forms (as a name like "Cm7" matches both Minor Triad and Minor Seventh), each distinct set of tones containing the root only once, simplest first
+var templates []template
+
+func init() {
+	seen := make(map[toneSet]bool)
+	for i := range forms {
+		for j := i; j < len(forms); j++ {
+			t := template{forms: []Form{forms[i], forms[j]}}
+			t.tones = toneSetOf(chordOfForms(note.C, note.Sharp, t.forms...))
+			if !t.tones.semitones[0] || seen[t.tones] {
+				continue
+			}
+			seen[t.tones] = true
+			templates = append(templates, t)
+		}
+	}
+}
+
+// chordOfForms builds the chord of the Basic form plus the given forms, in order, from the given root
+func chordOfForms(root note.Class, adjSymbol note.AdjSymbol, with ...Form) Chord {
+	c := Chord{Root: root, AdjSymbol: adjSymbol, Tones: make(map[Interval]note.Class)}
+	toDelete := c.applyForm(forms[0])
+	for _, f := range with {
+		toDelete = append(toDelete, c.applyForm(f)...)
+	}
+	for _, i := range toDelete {
+		delete(c.Tones, i)
+	}
+	return c
+}
+
+// distinctClasses of a set, in the order given, beginning with the bass; non-chromatic classes are ignored
+func distinctClasses(classes []note.Class) (bass note.Class, distinct []note.Class) {
+	seen := make(map[note.Class]bool)
+	for _, class := range classes {
+		if !class.IsChromatic() || seen[class] {
+			continue
+		}
+		seen[class] = true
+		distinct = append(distinct, class)
+	}
+	if len(distinct) > 0 {
+		bass = distinct[0]
+	}
+	return
+}
+
+// toneSetOfClasses relative to the given root
+func toneSetOfClasses(root note.Class, classes []note.Class) (t toneSet) {
+	for _, class := range classes {
+		t.semitones[(root.Diff(class)+12)%12] = true
+	}
+	return
+}
+
+// similarity of the heard tones to a template, as the number of shared tones over the number of tones in either
+func similarity(heard toneSet, template toneSet) float64 {
+	var shared, either int
+	for s := 0; s < 12; s++ {
+		if heard.semitones[s] && template.semitones[s] {
+			shared++
+		}
+		if heard.semitones[s] || template.semitones[s] {
+			either++
+		}
+	}
+	if template.microtonal {
+		either++
+	}
+	if either == 0 {
+		return 0
+	}
+	return float64(shared) / float64(either)
+}
+
+// identifyAdjSymbolOf a root, conventionally spelling Db, Eb, Ab and Bb with flats and all others with sharps
+func identifyAdjSymbolOf(root note.Class) note.AdjSymbol {
+	switch root {
+	case note.Cs, note.Ds, note.Gs, note.As:
+		return note.Flat
+	}
+	return note.Sharp
+}
@@ -0,0 +1,74 @@
+// Chords can be identified from a set of pitch classes, e.g. {E, G, C} is C/E, using the known chord forms as the vocabulary.
+package chord
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestIdentify(t *testing.T) {
+	assertIdentifiedFirst(t, "C", note.C, note.E, note.G)
+	assertIdentifiedFirst(t, "C/E", note.E, note.G, note.C)
+	assertIdentifiedFirst(t, "C/G", note.G, note.C, note.E)
+	assertIdentifiedFirst(t, "Am", note.A, note.C, note.E)
+	assertIdentifiedFirst(t, "G7/B", note.B, note.D, note.F, note.G)
+	assertIdentifiedFirst(t, "Cmaj7", note.C, note.E, note.G, note.B)
+	assertIdentifiedFirst(t, "Bdim", note.B, note.D, note.F)
+	assertIdentifiedFirst(t, "Ebm7", note.Ds, note.Fs, note.As, note.Cs)
+	assertIdentifiedFirst(t, "C6", note.C, note.E, note.G, note.A)
+	assertIdentifiedFirst(t, "G7omit5", note.G, note.B, note.F)
+	assertIdentifiedFirst(t, "Cm(maj7)", note.C, note.Ds, note.G, note.B)
+}
+
+func TestIdentify_Ambiguous(t *testing.T) {
+	names := identifiedNames(note.C, note.E, note.G, note.A)
+	assert.Contains(t, names, "C6")
+	assert.Contains(t, names, "Am7/C")
+}
+
+func TestIdentify_Confidence(t *testing.T) {
+	candidates := Identify([]note.Class{note.C, note.E, note.G})
+	assert.Equal(t, 1.0, candidates[0].Confidence)
+	for i := 1; i < len(candidates); i++ {
+		assert.True(t, candidates[i].Confidence <= candidates[i-1].Confidence)
+	}
+	assert.Equal(t, 0.9, Identify([]note.Class{note.E, note.G, note.C})[0].Confidence)
+}
+
+func TestIdentify_Incomplete(t *testing.T) {
+	// a cluster nearest to an added ninth chord, with a passing tone
+	candidates := Identify([]note.Class{note.C, note.Cs, note.D, note.E, note.G})
+	assert.Equal(t, "Cadd9", candidates[0].Chord.Name())
+	assert.Equal(t, 0.8, candidates[0].Confidence)
+}
+
+func TestIdentify_LoneRoot(t *testing.T) {
+	assertIdentifiedFirst(t, "C", note.C)
+	assertIdentifiedFirst(t, "Eb", note.Ds, note.Ds)
+}
+
+func TestIdentify_Empty(t *testing.T) {
+	assert.Equal(t, 0, len(Identify([]note.Class{})))
+	assert.Equal(t, 0, len(Identify([]note.Class{note.Nil})))
+}
+
+//
+// Private
+//
+
+func assertIdentifiedFirst(t *testing.T, expect string, classes ...note.Class) {
+	candidates := Identify(classes)
+	if assert.True(t, len(candidates) > 0, expect) {
+		assert.Equal(t, expect, candidates[0].Chord.Name())
+	}
+}
+
+func identifiedNames(classes ...note.Class) (names []string) {
+	for _, c := range Identify(classes) {
+		names = append(names, c.Chord.Name())
+	}
+	return
+}
@@ -0,0 +1,337 @@
+// Voice leading moves each voice of one chord to a tone of the next with as little motion as possible, e.g. from C4 E4 G4 to B3 F4 G4 for G7.
+package chord
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ErrParallels is returned when every voicing of the next chord would move in parallel fifths or octaves
+var ErrParallels = errors.New("chord cannot be voiced without parallel fifths or octaves")
+
+// VoiceLeading constraints, by which the next Chord is voiced from the previous voicing
+type VoiceLeading struct {
+	Voicing        Voicing // Technique, register and span of the next voicing; the inversion is chosen by the voice leading, unless the Chord has a slash Bass
+	AvoidParallels bool    // Avoid parallel fifths and octaves between any two voices
+}
+
+// Motion of one voice from the previous voicing to the next
+type Motion struct {
+	From      *note.Note // Note of the voice in the previous voicing
+	To        *note.Note // Note of the voice in the next voicing
+	Semitones int        // +/- semitones moved, e.g. -1 from C4 to B3
+	Common    bool       // The voice holds a tone common to both chords, without moving, e.g. not by an octave leap
+	Parallel  bool       // The voice moves in parallel fifths or octaves with another voice
+}
+
+// LeadFrom the previous voicing, to the voicing of this Chord that minimizes the total semitone motion of all voices, holding common tones wherever possible.
+// With a GenericTechnique, the next voicing has the same number of voices as the previous, each moving to a nearby chord tone without crossing another voice; otherwise the voicings of the given Technique are considered, in every inversion.
+// Returns the next voicing, from lowest to highest, plus the Motion of each voice of the next voicing.
+func (this Chord) LeadFrom(previous []*note.Note, leading VoiceLeading) ([]*note.Note, []Motion, error) {
+	if len(previous) == 0 {
+		next, err := this.Voice(leading.Voicing)
+		return next, nil, err
+	}
+
+	from := sortedMIDI(previous)
+	if leading.Voicing.Technique == GenericTechnique {
+		return this.leadGeneric(from, leading)
+	}
+	candidates := this.leadingCandidates(from, leading.Voicing)
+	if len(candidates) == 0 {
+		return nil, nil, ErrRegister
+	}
+
+	var best []int
+	bestCost, bestCommon := 0, 0
+	for _, to := range candidates {
+		if leading.AvoidParallels && hasParallels(from, to) {
+			continue
+		}
+		cost, common := motionCost(from, to)
+		if best == nil || cost < bestCost || (cost == bestCost && common > bestCommon) {
+			best, bestCost, bestCommon = to, cost, common
+		}
+	}
+	if best == nil {
+		return nil, nil, ErrParallels
+	}
+
+	next := notesOfMIDI(best)
+	return next, motionsOf(from, best), nil
+}
+
+//
+// Private
+//
+
+// leadingCandidates are all the voicings of the Chord of a Technique other than GenericTechnique, considered for leading from the given pitches
+func (this Chord) leadingCandidates(from []int, v Voicing) (candidates [][]int) {
+	var basses []note.Class
+	if this.Bass != note.Nil {
+		basses = []note.Class{this.Bass}
+	} else {
+		basses = this.voicingTones()
+	}
+	for _, bass := range basses {
+		placements, err := this.voicings(v, bass)
+		if err == nil {
+			candidates = append(candidates, placements...)
+		}
+	}
+	return
+}
+
+// leadGeneric leads from the given pitches with a GenericTechnique, to the same number of voices, returning ErrRegister if no voicing fits the constraints, or ErrParallels if every voicing that does has parallel fifths or octaves
+func (this Chord) leadGeneric(from []int, leading VoiceLeading) ([]*note.Note, []Motion, error) {
+	best := this.nearestGeneric(from, leading.Voicing, leading.AvoidParallels)
+	if best == nil {
+		if leading.AvoidParallels && this.nearestGeneric(from, leading.Voicing, false) != nil {
+			return nil, nil, ErrParallels
+		}
+		return nil, nil, ErrRegister
+	}
+	return notesOfMIDI(best), motionsOf(from, best), nil
+}
+
+// nearestGeneric voicing of the Chord to the given pitches, which minimizes the total semitone motion of all voices, holding the most common tones among voicings of equal motion, or nil if there is none.
+// The nearest chord tones of each voice are searched depth-first, from lowest voice to highest, cutting any branch that cannot move less than the best voicing found so far, or cannot hold the tones the chord requires.
+func (this Chord) nearestGeneric(from []int, v Voicing, avoidParallels bool) (best []int) {
+	tones := this.voicingTones()
+	if this.Bass != note.Nil && !containsClass(tones, this.Bass) {
+		tones = append(tones, this.Bass)
+	}
+	required := len(tones)
+	if required > len(from) {
+		required = len(from)
+	}
+
+	// the least motion of all the voices from each voice up
+	options := leadingOptions(from, tones, v)
+	least := make([]int, len(from)+1)
+	for i := len(from) - 1; i >= 0; i-- {
+		if len(options[i]) == 0 {
+			return nil
+		}
+		least[i] = least[i+1] + abs(options[i][0]-from[i])
+	}
+
+	// whether any voice from each voice up may hold the root
+	rootAbove := make([]bool, len(from)+1)
+	for i := len(from) - 1; i >= 0; i-- {
+		rootAbove[i] = rootAbove[i+1] || containsClass(classesOfMIDI(options[i]), this.Root)
+	}
+
+	to := make([]int, len(from))
+	bestCost, bestCommon := 0, 0
+	var choose func(i int, cost int, common int)
+	choose = func(i int, cost int, common int) {
+		// cut any branch whose remaining voices cannot hold the missing chord tones
+		if countClasses(to[:i])+len(from)-i < required {
+			return
+		}
+		if this.Root.IsChromatic() && !rootAbove[i] && !containsClass(classesOfMIDI(to[:i]), this.Root) {
+			return
+		}
+		if i == len(from) {
+			if best == nil || cost < bestCost || (cost == bestCost && common > bestCommon) {
+				best, bestCost, bestCommon = append([]int{}, to...), cost, common
+			}
+			return
+		}
+		for _, target := range options[i] {
+			d := abs(target - from[i])
+			if best != nil && cost+d+least[i+1] > bestCost {
+				break // options are nearest first, so no further option can do better
+			}
+			if i > 0 && target < to[i-1] {
+				continue // voices must not cross
+			}
+			if v.MaxSpan > 0 && i > 0 && target-to[0] > v.MaxSpan {
+				continue
+			}
+			if i == 0 && this.Bass != note.Nil && classOfSemitone(target) != this.Bass {
+				continue
+			}
+			to[i] = target
+			if avoidParallels && parallelBelow(from, to, i) {
+				continue
+			}
+			if d == 0 {
+				choose(i+1, cost, common+1)
+			} else {
+				choose(i+1, cost+d, common)
+			}
+		}
+	}
+	choose(0, 0, 0)
+	return
+}
+
+// leadingOptions of each voice, the chord tones within reach of its pitch and within the register, nearest first, at most leadingOptionsPerVoice of them
+func leadingOptions(from []int, tones []note.Class, v Voicing) [][]int {
+	low, high := v.register()
+	options := make([][]int, len(from))
+	for i, p := range from {
+		for _, class := range tones {
+			for _, target := range []int{nextAbove(p-7, class), nextAbove(p-7, class) + 12} {
+				if target-p <= leadingReach && target >= low && target <= high {
+					options[i] = append(options[i], target)
+				}
+			}
+		}
+		sort.Slice(options[i], func(a, b int) bool {
+			da, db := abs(options[i][a]-p), abs(options[i][b]-p)
+			return da < db || (da == db && options[i][a] < options[i][b])
+		})
+		if len(options[i]) > leadingOptionsPerVoice {
+			options[i] = options[i][:leadingOptionsPerVoice]
+		}
+	}
+	return options
+}
+
+// parallelBelow is true if the voice moves in parallel fifths or octaves with any voice below it
+func parallelBelow(from []int, to []int, i int) bool {
+	for j := 0; j < i; j++ {
+		if isParallel(from, to, j, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Furthest any voice may move, in semitones, and most chord tones considered for each voice, when leading with a GenericTechnique
+const (
+	leadingReach           = 7
+	leadingOptionsPerVoice = 6
+)
+
+// pairedVoice of the previous voicing, for each voice of the next voicing: the same voice if both have the same number of voices, else the nearest
+func pairedVoice(from []int, to []int, i int) int {
+	if len(from) == len(to) {
+		return i
+	}
+	nearest := 0
+	for j, p := range from {
+		if abs(to[i]-p) < abs(to[i]-from[nearest]) {
+			nearest = j
+		}
+	}
+	return nearest
+}
+
+// motionCost is the total semitones moved by all voices, and the number of voices holding a common tone
+func motionCost(from []int, to []int) (cost int, common int) {
+	for i := range to {
+		d := to[i] - from[pairedVoice(from, to, i)]
+		cost += abs(d)
+		if d == 0 {
+			common++
+		}
+	}
+	return
+}
+
+// hasParallels is true if any two voices move in the same direction from a perfect fifth or octave to the same interval
+func hasParallels(from []int, to []int) bool {
+	for i := range to {
+		for j := i + 1; j < len(to); j++ {
+			if isParallel(from, to, i, j) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isParallel is true if the two voices move in the same direction from a perfect fifth or octave to the same interval
+func isParallel(from []int, to []int, i int, j int) bool {
+	fi, fj := from[pairedVoice(from, to, i)], from[pairedVoice(from, to, j)]
+	before := ((fj-fi)%12 + 12) % 12
+	after := ((to[j]-to[i])%12 + 12) % 12
+	if before != after || (before != 0 && before != 7) || fi == fj {
+		return false
+	}
+	mi, mj := to[i]-fi, to[j]-fj
+	return mi != 0 && mj != 0 && (mi > 0) == (mj > 0)
+}
+
+// motionsOf each voice of the next voicing
+func motionsOf(from []int, to []int) (motions []Motion) {
+	fromNotes := notesOfMIDI(from)
+	toNotes := notesOfMIDI(to)
+	for i := range to {
+		j := pairedVoice(from, to, i)
+		parallel := false
+		for k := range to {
+			if k != i && isParallel(from, to, minInt(i, k), maxInt(i, k)) {
+				parallel = true
+			}
+		}
+		motions = append(motions, Motion{
+			From:      fromNotes[j],
+			To:        toNotes[i],
+			Semitones: to[i] - from[j],
+			Common:    to[i] == from[j],
+			Parallel:  parallel,
+		})
+	}
+	return
+}
+
+// sortedMIDI note numbers of the notes, from lowest to highest
+func sortedMIDI(notes []*note.Note) (pitches []int) {
+	for _, n := range notes {
+		pitches = append(pitches, n.MIDI())
+	}
+	sort.Ints(pitches)
+	return
+}
+
+func classesOfMIDI(pitches []int) (classes []note.Class) {
+	for _, p := range pitches {
+		classes = append(classes, classOfSemitone(p))
+	}
+	return
+}
+
+func countClasses(pitches []int) int {
+	seen := make(map[note.Class]bool)
+	for _, p := range pitches {
+		seen[classOfSemitone(p)] = true
+	}
+	return len(seen)
+}
+
+func containsClass(classes []note.Class, class note.Class) bool {
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,117 @@
+// Voice leading moves each voice of one chord to a tone of the next with as little motion as possible, e.g. from C4 E4 G4 to B3 F4 G4 for G7.
+package chord
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestLeadFrom(t *testing.T) {
+	assertLeadFrom(t, "C4 F4 A4", "C4 E4 G4", "F", VoiceLeading{})
+	assertLeadFrom(t, "B3 D4 G4", "C4 E4 G4", "G", VoiceLeading{})
+	assertLeadFrom(t, "B3 F4 G4", "C4 E4 G4", "G7", VoiceLeading{})
+	assertLeadFrom(t, "C4 E4 A4", "C4 E4 G4", "Am", VoiceLeading{})
+	assertLeadFrom(t, "G2 C4 E4 G4", "G2 B3 D4 G4", "C", VoiceLeading{Voicing: Voicing{Low: note.Named("C2")}})
+}
+
+func TestLeadFrom_CommonTones(t *testing.T) {
+	next, motions, err := Of("Am").LeadFrom(voicingNamed("C4 E4 G4"), VoiceLeading{})
+	assert.Nil(t, err)
+	assert.Equal(t, "C4 E4 A4", notesString(next))
+	assert.Equal(t, 3, len(motions))
+	assert.True(t, motions[0].Common)
+	assert.Equal(t, 0, motions[0].Semitones)
+	assert.True(t, motions[1].Common)
+	assert.False(t, motions[2].Common)
+	assert.Equal(t, 2, motions[2].Semitones)
+	assert.Equal(t, note.G, motions[2].From.Class)
+	assert.Equal(t, note.A, motions[2].To.Class)
+}
+
+func Test_motionsOf_Common(t *testing.T) {
+	// C4 held, and G4 leaping an octave to G5, which is the same pitch class but is not held
+	motions := motionsOf([]int{60, 64, 67}, []int{60, 67, 79})
+	assert.True(t, motions[0].Common)
+	assert.False(t, motions[2].Common)
+	assert.Equal(t, 12, motions[2].Semitones)
+	_, common := motionCost([]int{60, 64, 67}, []int{60, 67, 79})
+	assert.Equal(t, 1, common)
+}
+
+func TestLeadFrom_Parallels(t *testing.T) {
+	// in an outer-voice skeleton, C to D would move in parallel fifths and octaves
+	from := voicingNamed("C3 G3 C4")
+	next, motions, err := Of("D5").LeadFrom(from, VoiceLeading{Voicing: Voicing{Low: note.Named("C2")}})
+	assert.Nil(t, err)
+	assert.Equal(t, "D3 A3 D4", notesString(next))
+	assert.True(t, motions[0].Parallel)
+
+	next, motions, err = Of("D5").LeadFrom(from, VoiceLeading{AvoidParallels: true, Voicing: Voicing{Low: note.Named("C2")}})
+	assert.Nil(t, err)
+	for _, m := range motions {
+		assert.False(t, m.Parallel, notesString(next))
+	}
+}
+
+func TestLeadFrom_Technique(t *testing.T) {
+	next, motions, err := Of("Dm7").LeadFrom(voicingNamed("C4 G4 B4 E5"), VoiceLeading{Voicing: Voicing{Technique: Drop2Technique}})
+	assert.Nil(t, err)
+	assert.Equal(t, "C4 F4 A4 D5", notesString(next))
+	assert.Equal(t, 4, len(motions))
+}
+
+func TestLeadFrom_SlashBass(t *testing.T) {
+	next, _, err := Of("C/E").LeadFrom(voicingNamed("D4 F4 B4"), VoiceLeading{})
+	assert.Nil(t, err)
+	assert.Equal(t, note.E, next[0].Class)
+}
+
+func TestLeadFrom_ManyVoices(t *testing.T) {
+	leading := VoiceLeading{AvoidParallels: true, Voicing: Voicing{Low: note.Named("C1"), High: note.Named("C7")}}
+	for _, from := range []string{
+		"C2 G2 E3 C4 G4 E5 C6 G6",
+		"C2 G2 E3 Bb3 D4 G4 C5 E5 G5 C6",
+		"C1 C2 G2 E3 Bb3 D4 G4 C5 E5 G5 C6 E6",
+	} {
+		start := time.Now()
+		next, motions, err := Of("F13").LeadFrom(voicingNamed(from), leading)
+		assert.True(t, time.Since(start) < time.Second, from)
+		assert.Nil(t, err, from)
+		assert.Equal(t, len(strings.Fields(from)), len(next), from)
+		for i, m := range motions {
+			assert.True(t, abs(m.Semitones) <= leadingReach, from)
+			if i > 0 {
+				assert.True(t, next[i-1].MIDI() <= next[i].MIDI(), from)
+			}
+		}
+	}
+}
+
+func TestLeadFrom_NoPrevious(t *testing.T) {
+	next, motions, err := Of("C").LeadFrom(nil, VoiceLeading{})
+	assert.Nil(t, err)
+	assert.Equal(t, "C4 E4 G4", notesString(next))
+	assert.Equal(t, 0, len(motions))
+}
+
+//
+// Private
+//
+
+func assertLeadFrom(t *testing.T, expect string, from string, name string, leading VoiceLeading) {
+	next, _, err := Of(name).LeadFrom(voicingNamed(from), leading)
+	assert.Nil(t, err, name)
+	assert.Equal(t, expect, notesString(next), name)
+}
+
+func voicingNamed(names string) (notes []*note.Note) {
+	for _, name := range strings.Fields(names) {
+		notes = append(notes, note.Named(name))
+	}
+	return
+}
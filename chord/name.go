@@ -0,0 +1,399 @@
+// Chords can be named by their conventional symbol, e.g. Cm7b5/Gb, in a Style such as Pop "Cm7", Jazz "Cmi7" or Symbol "C−7".
+package chord
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Style of a chord symbol, e.g. Pop "Cm7", Jazz "Cmi7" or Symbol "C−7"
+type Style int
+
+const (
+	PopStyle Style = iota
+	JazzStyle
+	SymbolStyle
+)
+
+// Name of the Chord as its shortest conventional symbol in the Pop style, e.g. "Cm7b5/Gb", such that Of(c.Name()) has the same tones.
+func (this Chord) Name() string {
+	return this.NameWith(PopStyle)
+}
+
+// NameWith the given Style, e.g. "Cm7" (Pop), "Cmi7" (Jazz) or "C−7" (Symbol).
+// The name is derived from the tones of the Chord: triad quality, seventh, extensions, alterations, omissions and slash bass.
+// Of all the conventional names for those tones, the shortest one that parses back into the same tones is chosen; a chord whose tones cannot be expressed by the known forms is named as closely as possible.
+// A lone root is named by the root alone, e.g. "C".
+func (this Chord) NameWith(style Style) string {
+	if !this.Root.IsChromatic() {
+		return ""
+	}
+
+	root := this.Root.Spelled(this.AdjSymbol).String()
+	bass := ""
+	if this.Bass != note.Nil {
+		bass = "/" + this.bassString()
+	}
+
+	tones := toneSetOf(this)
+	if tones == loneRootToneSet {
+		return root + bass
+	}
+	candidates := symbolCandidates(tones, symbolStyles[style])
+	best := ""
+	for _, suffix := range candidates {
+		name := root + suffix + bass
+		if toneSetOf(Of(name)) == tones && (best == "" || utf8.RuneCountInString(name) < utf8.RuneCountInString(best)) {
+			best = name
+		}
+	}
+	if best == "" && len(candidates) > 0 {
+		best = root + candidates[0] + bass
+	}
+	return best
+}
+
+//
+// Private
+//
+
+// bassString of a slash chord, spelled the same as the chord tone it doubles
+func (this Chord) bassString() string {
+	spelled := this.Spelled()
+	for _, i := range intervalOrder {
+		if sp, ok := spelled[i]; ok && this.Tones[i] == this.Bass {
+			return sp.String()
+		}
+	}
+	return this.Bass.String(this.AdjSymbol)
+}
+
+// toneSet of a chord, as the semitones (0-11) of its pitch classes above the root, plus whether it has any microtonal tone (e.g. a harmonic seventh)
+type toneSet struct {
+	semitones  [12]bool
+	microtonal bool
+}
+
+func toneSetOf(c Chord) (t toneSet) {
+	if !c.Root.IsChromatic() {
+		return
+	}
+	for _, class := range c.Tones {
+		if class.IsChromatic() {
+			t.semitones[(c.Root.Diff(class)+12)%12] = true
+		} else if class != note.Nil {
+			t.microtonal = true
+		}
+	}
+	return
+}
+
+// symbolStyle is the vocabulary of a Style of chord symbol
+type symbolStyle struct {
+	minor          string // e.g. "m" for Cm7
+	major          string // e.g. "maj" for Cmaj7
+	diminished     string // e.g. "dim" for Cdim
+	diminished7    string // e.g. "dim7" for Cdim7
+	augmented      string // e.g. "aug" for Caug
+	halfDiminished string // e.g. "m%sb5" for Cm7b5, where %s is the extension
+	minorMajor     string // e.g. "m(maj%s)" for Cm(maj7), where %s is the extension
+	parentheses    bool   // always enclose the alterations in parentheses, e.g. C7(b9)
+}
+
+var symbolStyles = map[Style]symbolStyle{
+	PopStyle: {
+		minor:          "m",
+		major:          "maj",
+		diminished:     "dim",
+		diminished7:    "dim7",
+		augmented:      "aug",
+		halfDiminished: "m%sb5",
+		minorMajor:     "m(maj%s)",
+	},
+	JazzStyle: {
+		minor:          "mi",
+		major:          "ma",
+		diminished:     "dim",
+		diminished7:    "dim7",
+		augmented:      "+",
+		halfDiminished: "mi%s(b5)",
+		minorMajor:     "mi(ma%s)",
+		parentheses:    true,
+	},
+	SymbolStyle: {
+		minor:          "−",
+		major:          "Δ",
+		diminished:     "°",
+		diminished7:    "°7",
+		augmented:      "+",
+		halfDiminished: "ø%s",
+		minorMajor:     "−Δ%s",
+	},
+}
+
+// Triad quality, as determined by the third and fifth of a chord
+type triadQuality int
+
+const (
+	majorTriad triadQuality = iota
+	minorTriad
+	diminishedTriad
+	augmentedTriad
+	suspendedTriad
+	noThirdTriad
+)
+
+// Seventh of a chord, if any
+type seventhKind int
+
+const (
+	noSeventh seventhKind = iota
+	dominantSeventh
+	majorSeventh
+	diminishedSeventh
+	harmonicSeventh
+)
+
+// Fifth of a chord, which may be perfect, altered into the triad quality, altered as a symbol (e.g. b5) or omitted
+type fifthKind int
+
+const (
+	perfectFifth fifthKind = iota
+	triadFifth
+	flatFifth
+	sharpFifth
+	omitFifth
+)
+
+// symbolCandidates are all the conventional chord symbols (without root or bass) for a set of tones, in order of preference
+func symbolCandidates(t toneSet, style symbolStyle) (candidates []string) {
+	has := func(semitones int) bool { return t.semitones[semitones] }
+
+	// the third determines the basic quality
+	quality := noThirdTriad
+	var thirdTones []int
+	switch {
+	case has(4):
+		quality, thirdTones = majorTriad, []int{4}
+	case has(3):
+		quality, thirdTones = minorTriad, []int{3}
+	case has(5):
+		quality, thirdTones = suspendedTriad, []int{5}
+	}
+
+	// the fifth may be perfect, or altered in one or more ways
+	var fifths []fifthKind
+	switch {
+	case has(7):
+		fifths = []fifthKind{perfectFifth}
+	case quality == minorTriad && has(6), quality == majorTriad && has(8):
+		fifths = []fifthKind{triadFifth, flatFifth, sharpFifth}
+	case has(6):
+		fifths = []fifthKind{flatFifth, sharpFifth}
+	case has(8):
+		fifths = []fifthKind{sharpFifth}
+	default:
+		fifths = []fifthKind{omitFifth}
+	}
+
+	for _, fifth := range fifths {
+		consumed := [12]bool{0: true}
+		for _, s := range thirdTones {
+			consumed[s] = true
+		}
+		q := quality
+		switch fifth {
+		case perfectFifth:
+			consumed[7] = true
+		case triadFifth:
+			if quality == minorTriad {
+				q = diminishedTriad
+				consumed[6] = true
+			} else {
+				q = augmentedTriad
+				consumed[8] = true
+			}
+		case flatFifth:
+			consumed[6] = true
+		case sharpFifth:
+			consumed[8] = true
+		}
+
+		// the seventh, if any
+		seventh := noSeventh
+		switch {
+		case t.microtonal:
+			seventh = harmonicSeventh
+		case q == diminishedTriad && has(9) && !has(10):
+			seventh = diminishedSeventh
+			consumed[9] = true
+		case has(10):
+			seventh = dominantSeventh
+			consumed[10] = true
+		case has(11):
+			seventh = majorSeventh
+			consumed[11] = true
+		}
+
+		// extensions beyond the seventh, e.g. 9, 11 or 13, each implying the natural extensions below it
+		extensions := []int{7}
+		if seventh == dominantSeventh || seventh == majorSeventh {
+			for _, ext := range []int{9, 11, 13} {
+				if has(extensionSemitones[ext]) && !consumed[extensionSemitones[ext]] {
+					extensions = append(extensions, ext)
+				}
+			}
+		}
+
+		for _, ext := range extensions {
+			extConsumed := consumed
+			for _, lower := range []int{9, 11, 13} {
+				if lower <= ext && has(extensionSemitones[lower]) {
+					extConsumed[extensionSemitones[lower]] = true
+				}
+			}
+			sixth := seventh == noSeventh && has(9) && !extConsumed[9]
+			if sixth {
+				extConsumed[9] = true
+			}
+			base := symbolBase(style, q, seventh, ext, sixth)
+			alterations := symbolAlterations(t, extConsumed, fifth)
+			candidates = append(candidates, symbolWith(style, base, alterations)...)
+		}
+	}
+	if t == alteredDominantToneSet {
+		candidates = append(candidates, "7alt")
+	}
+	for i := range candidates {
+		if !t.semitones[0] {
+			candidates[i] += " nondominant"
+		}
+	}
+	return
+}
+
+// alteredDominantToneSet is named by its conventional symbol, e.g. C7alt, because its alterations cannot be spelled out unambiguously
+var alteredDominantToneSet = func() (t toneSet) {
+	t.semitones[0] = true
+	for _, s := range alteredDominantFormAdd {
+		t.semitones[s%12] = true
+	}
+	return
+}()
+
+// loneRootToneSet of a chord of its root alone
+var loneRootToneSet = toneSet{semitones: [12]bool{0: true}}
+
+// Semitones of the natural extensions above the root, modulo octave
+var extensionSemitones = map[int]int{
+	9:  2,
+	11: 5,
+	13: 9,
+}
+
+// symbolBase of a chord symbol, for its triad quality, seventh, extension and added sixth, e.g. "m7" or "maj9" or "m6"
+func symbolBase(style symbolStyle, quality triadQuality, seventh seventhKind, ext int, sixth bool) string {
+	e := strconv.Itoa(ext)
+	var base string
+	switch seventh {
+	case noSeventh:
+		switch quality {
+		case majorTriad:
+			base = ""
+		case minorTriad:
+			base = style.minor
+		case diminishedTriad:
+			base = style.diminished
+		case augmentedTriad:
+			base = style.augmented
+		case suspendedTriad:
+			base = "sus4"
+		case noThirdTriad:
+			base = "5"
+		}
+		if sixth {
+			base += "6"
+		}
+	case dominantSeventh:
+		switch quality {
+		case minorTriad:
+			base = style.minor + e
+		case diminishedTriad:
+			base = strings.Replace(style.halfDiminished, "%s", e, 1)
+		case augmentedTriad:
+			base = style.augmented + e
+		case suspendedTriad:
+			base = e + "sus4"
+		default:
+			base = e
+		}
+	case majorSeventh:
+		switch quality {
+		case minorTriad:
+			base = strings.Replace(style.minorMajor, "%s", e, 1)
+		case diminishedTriad:
+			base = style.diminished + style.major + e
+		case augmentedTriad:
+			base = style.augmented + style.major + e
+		case suspendedTriad:
+			base = style.major + e + "sus4"
+		default:
+			base = style.major + e
+		}
+	case diminishedSeventh:
+		base = style.diminished7
+	case harmonicSeventh:
+		base = "harm7"
+	}
+	return base
+}
+
+// symbolAlterations are the tokens for all tones not already expressed by the base of the symbol, e.g. "b9" or "#11" or "add9"
+func symbolAlterations(t toneSet, consumed [12]bool, fifth fifthKind) (alterations []string) {
+	switch fifth {
+	case flatFifth:
+		alterations = append(alterations, "b5")
+	case sharpFifth:
+		alterations = append(alterations, "#5")
+	}
+	for _, a := range []struct {
+		semitones int
+		token     string
+	}{
+		{1, "b9"},
+		{2, "add9"},
+		{3, "#9"},
+		{5, "add11"},
+		{6, "#11"},
+		{8, "b13"},
+		{9, "add13"},
+	} {
+		if t.semitones[a.semitones] && !consumed[a.semitones] {
+			alterations = append(alterations, a.token)
+		}
+	}
+	if fifth == omitFifth {
+		alterations = append(alterations, "omit5")
+	}
+	return
+}
+
+// symbolWith the alterations appended to the base, both plainly (e.g. C7b9) and in parentheses (e.g. C7(b9)) unless the style always uses parentheses
+func symbolWith(style symbolStyle, base string, alterations []string) []string {
+	if len(alterations) == 0 {
+		return []string{base}
+	}
+	parenthesized := base + "(" + strings.Join(alterations, ",") + ")"
+	if style.parentheses {
+		return []string{parenthesized}
+	}
+	plain := base + strings.Join(alterations, "")
+	if len(base) == 0 && note.AdjSymbolBegin(plain) != note.No {
+		// an accidental immediately after the root would be read as part of the root, e.g. Cb5
+		return []string{parenthesized}
+	}
+	return []string{plain, parenthesized}
+}
@@ -0,0 +1,92 @@
+// Chords can be named by their conventional symbol, e.g. Cm7b5/Gb, in a Style such as Pop "Cm7", Jazz "Cmi7" or Symbol "C−7".
+package chord
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestName(t *testing.T) {
+	assertName(t, "C", "C", "C", "C")
+	assertName(t, "Cm7", "Cm7", "Cmi7", "C−7")
+	assertName(t, "C major 7", "Cmaj7", "Cma7", "CΔ7")
+	assertName(t, "C7", "C7", "C7", "C7")
+	assertName(t, "Cdim", "Cdim", "Cdim", "C°")
+	assertName(t, "Cdim7", "Cdim7", "Cdim7", "C°7")
+	assertName(t, "Cm7b5", "Cm7b5", "Cmi7(b5)", "Cø7")
+	assertName(t, "Caug", "Caug", "C+", "C+")
+	assertName(t, "Csus", "Csus4", "Csus4", "Csus4")
+	assertName(t, "C5", "C5", "C5", "C5")
+	assertName(t, "C6", "C6", "C6", "C6")
+	assertName(t, "Cm6", "Cm6", "Cmi6", "C−6")
+	assertName(t, "C9", "C9", "C9", "C9")
+	assertName(t, "Cmaj9", "Cmaj9", "Cma9", "CΔ9")
+	assertName(t, "Cm11", "Cm11", "Cmi11", "C−11")
+	assertName(t, "Cadd9", "Cadd9", "C(add9)", "Cadd9")
+	assertName(t, "C7b9", "C7b9", "C7(b9)", "C7b9")
+	assertName(t, "Cmaj7#11", "Cmaj7#11", "Cma7(#11)", "CΔ7#11")
+	assertName(t, "Cm(maj7)", "Cm(maj7)", "Cmi(ma7)", "C−Δ7")
+	assertName(t, "C(b5)", "C(b5)", "C(b5)", "C(b5)")
+	assertName(t, "C harmonic 7", "Charm7", "Charm7", "Charm7")
+	assertName(t, "Cm7b5/Gb", "Cm7b5/Gb", "Cmi7(b5)/Gb", "Cø7/Gb")
+	assertName(t, "Cm nondominant", "Cm nondominant", "Cmi nondominant", "C− nondominant")
+}
+
+func TestName_Transposed(t *testing.T) {
+	assert.Equal(t, "Ebm7", Of("Cm7").Transpose(3).Name())
+	assert.Equal(t, "D/F#", Of("C/E").Transpose(2).Name())
+	assert.Equal(t, "C#7/E#", Of("C#7/F").Name())
+}
+
+func TestName_Nil(t *testing.T) {
+	assert.Equal(t, "", Chord{}.Name())
+}
+
+func TestName_LoneRoot(t *testing.T) {
+	c := Chord{Root: note.C, Tones: map[Interval]note.Class{I1: note.C}}
+	assert.Equal(t, "C", c.Name())
+	assert.Equal(t, "C", c.NameWith(JazzStyle))
+	assert.Equal(t, "C", c.NameWith(SymbolStyle))
+	assert.Equal(t, "C", Of("C").Name())
+}
+
+// Every chord form in the ChordFormList, applied to every root, is named such that parsing the name reproduces the same tones
+func TestName_RoundTripAllForms(t *testing.T) {
+	for _, formName := range ChordFormList {
+		f := formNamed(formName)
+		for _, root := range []note.Class{note.C, note.Cs, note.D, note.Ds, note.E, note.F, note.Fs, note.G, note.Gs, note.A, note.As, note.B} {
+			for _, adj := range []note.AdjSymbol{note.Sharp, note.Flat} {
+				c := chordOfForms(root, adj, f)
+				for _, style := range []Style{PopStyle, JazzStyle, SymbolStyle} {
+					name := c.NameWith(style)
+					assert.Equal(t, toneSetOf(c), toneSetOf(Of(name)), fmt.Sprintf("form:%v root:%v style:%v name:%v", f.Name, root.String(adj), style, name))
+				}
+			}
+		}
+	}
+}
+
+//
+// Private
+//
+
+func assertName(t *testing.T, name string, expectPop string, expectJazz string, expectSymbol string) {
+	c := Of(name)
+	assert.Equal(t, expectPop, c.Name(), name)
+	assert.Equal(t, expectPop, c.NameWith(PopStyle), name)
+	assert.Equal(t, expectJazz, c.NameWith(JazzStyle), name)
+	assert.Equal(t, expectSymbol, c.NameWith(SymbolStyle), name)
+}
+
+func formNamed(name string) Form {
+	for _, f := range forms {
+		if f.Name == name {
+			return f
+		}
+	}
+	return Form{}
+}
@@ -18,14 +18,24 @@ func (c Chord) ToYAML() string {
 
 func specFrom(c Chord) specChord {
 	s := specChord{}
-	s.Root = c.Root.String(c.AdjSymbol)
+	s.Root = c.Root.Spelled(c.AdjSymbol).String()
 	s.Tones = make(map[int]string)
+	spelled := c.Spelled()
 	for i, t := range c.Tones {
-		s.Tones[int(i)] = t.String(c.AdjSymbol)
+		if sp, ok := spelled[i]; ok {
+			s.Tones[int(i)] = sp.String()
+		} else {
+			s.Tones[int(i)] = t.String(c.AdjSymbol)
+		}
 	}
-	// Include bass note if present (slash chord)
+	// Include bass note if present (slash chord), spelled the same as the chord tone it doubles
 	if c.Bass != note.Nil {
 		s.Bass = c.Bass.String(c.AdjSymbol)
+		for i, t := range c.Tones {
+			if sp, ok := spelled[i]; ok && t == c.Bass {
+				s.Bass = sp.String()
+			}
+		}
 	}
 	return s
 }
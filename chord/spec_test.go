@@ -12,3 +12,9 @@ func TestToYAML(t *testing.T) {
 	out := c.ToYAML()
 	assert.Equal(t, "root: C\ntones:\n  1: C\n  3: Eb\n  6: A\n  7: Bb\n  9: D\n", out)
 }
+
+func TestToYAML_Spelled(t *testing.T) {
+	c := Of("C#7/E#")
+	out := c.ToYAML()
+	assert.Equal(t, "root: C#\nbass: E#\ntones:\n  1: C#\n  3: E#\n  5: G#\n  7: B\n", out)
+}
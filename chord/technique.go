@@ -1,16 +1,20 @@
 // Chords have different Techniques, such as Block, Chordioid, Guitar, Open, Power or Slash.
 package chord
 
+// Technique by which a Chord is voiced into notes, see Voice
 type Technique int
 
 const (
 	GenericTechnique Technique = iota
 
 	// Techniques
-	BlockTechnique
-	ChordioidTechnique
-	GuitarTechnique
-	OpenTechnique
-	PowerTechnique
-	SlashTechnique
+	BlockTechnique     // close position, all tones within an octave above the bass
+	ChordioidTechnique // close position, as Block
+	GuitarTechnique    // drop-2 for chords of four or more tones, else open, in the register of a guitar
+	OpenTechnique      // close position with every other voice above the bass raised an octave
+	PowerTechnique     // root, fifth and octave only
+	SlashTechnique     // bass alone, below the chord in close root position
+	Drop2Technique     // close position with the second-highest voice dropped an octave
+	Drop3Technique     // close position with the third-highest voice dropped an octave
+	SpreadTechnique    // bass alone, an octave or more below the other tones in close position
 )
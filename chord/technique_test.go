@@ -3,8 +3,18 @@ package chord
 
 import (
 	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
 )
 
 func TestTechnique(t *testing.T) {
-	// TODO
+	c := Of("Cmaj7")
+	voiced := make(map[string]Technique)
+	for _, technique := range []Technique{BlockTechnique, OpenTechnique, PowerTechnique, SlashTechnique, Drop2Technique, Drop3Technique, SpreadTechnique} {
+		notes, err := c.Voice(Voicing{Technique: technique})
+		assert.Nil(t, err)
+		_, isDuplicate := voiced[notesString(notes)]
+		assert.False(t, isDuplicate, notesString(notes))
+		voiced[notesString(notes)] = technique
+	}
 }
@@ -0,0 +1,291 @@
+// Chords are voiced into notes placed in octaves, e.g. Cmaj7 in Drop 2 voicing is C4 G4 B4 E5, according to a Technique, inversion, register range and maximum span.
+package chord
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Voicing constraints, by which a Chord is realized into notes with octaves
+type Voicing struct {
+	Technique Technique  // e.g. BlockTechnique for close position, or Drop2Technique
+	Inversion int        // 0 for root position, 1 for the second tone (e.g. the third) in the bass, etc. Ignored if the Chord has a slash Bass.
+	Low       *note.Note // Lowest note of the register, default C3 (or E2 for the Guitar technique)
+	High      *note.Note // Highest note of the register, default C6 (or E5 for the Guitar technique)
+	MaxSpan   int        // Maximum semitones between the lowest and highest notes, or 0 for no maximum
+}
+
+var (
+	ErrInversion = errors.New("chord has no such inversion")
+	ErrSpan      = errors.New("chord cannot be voiced within the maximum span")
+	ErrRegister  = errors.New("chord cannot be voiced within the register range")
+)
+
+// Voice the Chord into notes with octaves, from lowest to highest, under the given Voicing constraints.
+// The lowest note is always the slash Bass of the Chord if it has one, else the tone of the chosen inversion.
+// Of all the placements within the register, the one nearest the middle of the register is chosen.
+// Microtonal tones (e.g. a harmonic seventh) cannot be placed in an equal-tempered register, and are omitted.
+func (this Chord) Voice(v Voicing) ([]*note.Note, error) {
+	bass, err := this.voicingBass(v.Inversion)
+	if err != nil {
+		return nil, err
+	}
+	placements, err := this.voicings(v, bass)
+	if err != nil {
+		return nil, err
+	}
+
+	low, high := v.register()
+	middle := float64(low+high) / 2
+	best := placements[0]
+	for _, p := range placements[1:] {
+		if math.Abs(meanOf(p)-middle) < math.Abs(meanOf(best)-middle) {
+			best = p
+		}
+	}
+	return notesOfMIDI(best), nil
+}
+
+//
+// Private
+//
+
+// Default register, as MIDI note numbers
+const (
+	defaultLow        = 48 // C3
+	defaultHigh       = 84 // C6
+	defaultGuitarLow  = 40 // E2
+	defaultGuitarHigh = 76 // E5
+)
+
+// register of the Voicing, as the lowest and highest MIDI note numbers
+func (v Voicing) register() (low int, high int) {
+	low, high = defaultLow, defaultHigh
+	if v.Technique == GuitarTechnique {
+		low, high = defaultGuitarLow, defaultGuitarHigh
+	}
+	if v.Low != nil {
+		low = v.Low.MIDI()
+	}
+	if v.High != nil {
+		high = v.High.MIDI()
+	}
+	return
+}
+
+// voicings are all the placements (as ascending MIDI note numbers) of the Chord with the given bass, within the register and span of the Voicing
+func (this Chord) voicings(v Voicing, bass note.Class) (placements [][]int, err error) {
+	shape := this.voicingShape(v.Technique, bass)
+	if len(shape) == 0 {
+		return nil, ErrRegister
+	}
+	if v.MaxSpan > 0 && shape[len(shape)-1]-shape[0] > v.MaxSpan {
+		return nil, ErrSpan
+	}
+
+	low, high := v.register()
+	for shift := 12 * int(math.Floor(float64(low-shape[0])/12)); shift+shape[0] <= high; shift += 12 {
+		if shift+shape[0] < low || shift+shape[len(shape)-1] > high {
+			continue
+		}
+		p := make([]int, len(shape))
+		for i, s := range shape {
+			p[i] = s + shift
+		}
+		placements = append(placements, p)
+	}
+	if len(placements) == 0 {
+		return nil, ErrRegister
+	}
+	return
+}
+
+// voicingTones are the distinct chromatic tones of the Chord, from the root outward
+func (this Chord) voicingTones() (tones []note.Class) {
+	seen := make(map[note.Class]bool)
+	forAllIn(this.Tones, func(class note.Class) {
+		if class.IsChromatic() && !seen[class] {
+			seen[class] = true
+			tones = append(tones, class)
+		}
+	})
+	return
+}
+
+// voicingBass is the slash Bass of the Chord, else the tone of the given inversion
+func (this Chord) voicingBass(inversion int) (note.Class, error) {
+	if this.Bass != note.Nil {
+		return this.Bass, nil
+	}
+	tones := this.voicingTones()
+	if inversion < 0 || inversion >= len(tones) {
+		return note.Nil, ErrInversion
+	}
+	return tones[inversion], nil
+}
+
+// voicingShape of the Chord with the given bass and Technique, as ascending pitches (in semitones, with the bass in the lowest octave from 0 to 11)
+func (this Chord) voicingShape(technique Technique, bass note.Class) []int {
+	tones := this.voicingTones()
+	if len(tones) == 0 || !bass.IsChromatic() {
+		return nil
+	}
+	shape := techniqueShape(technique, this.Root, tones, bass)
+	shift := 12 * int(math.Floor(float64(shape[0])/12))
+	for i := range shape {
+		shape[i] -= shift
+	}
+	return shape
+}
+
+// techniqueShape of the tones with the given bass, as ascending pitches in semitones
+func techniqueShape(technique Technique, root note.Class, tones []note.Class, bass note.Class) []int {
+	switch technique {
+	case OpenTechnique:
+		return openShape(closeShape(withBassFirst(tones, bass)))
+	case PowerTechnique:
+		fifth, _ := root.Step(7)
+		if bass == root {
+			return closeShape([]note.Class{root, fifth, root})
+		}
+		return bassBelow(bass, closeShape([]note.Class{root, fifth, root}))
+	case SlashTechnique:
+		return bassBelow(bass, closeShape(tones))
+	case SpreadTechnique:
+		upper := closeShape(without(tones, bass))
+		if len(upper) == 0 {
+			return closeShape(withBassFirst(tones, bass))
+		}
+		spread := bassBelow(bass, upper)
+		spread[0] -= 12
+		return spread
+	case Drop2Technique:
+		return dropShape(tones, bass, 2)
+	case Drop3Technique:
+		return dropShape(tones, bass, 3)
+	case GuitarTechnique:
+		if len(tones) >= 4 {
+			return dropShape(tones, bass, 2)
+		}
+		return openShape(closeShape(withBassFirst(tones, bass)))
+	}
+	return closeShape(withBassFirst(tones, bass))
+}
+
+// closeShape stacks each class at its next occurrence above the previous, the first from 0 to 11
+func closeShape(classes []note.Class) (shape []int) {
+	for i, class := range classes {
+		if i == 0 {
+			shape = append(shape, semitoneOf(class))
+		} else {
+			shape = append(shape, nextAbove(shape[i-1], class))
+		}
+	}
+	return
+}
+
+// openShape raises every other voice above the bass of a close shape by an octave, e.g. C E G B becomes C G E B
+func openShape(close []int) []int {
+	if len(close) < 3 {
+		return close
+	}
+	open := append([]int{}, close...)
+	for i := 1; i < len(open); i += 2 {
+		open[i] += 12
+	}
+	sort.Ints(open)
+	return open
+}
+
+// dropShape drops the nth-highest voice of a close shape by an octave, from whichever rotation of the tones results in the given bass;
+// a bass that is not a chord tone is placed below the drop voicing of the chord in root position
+func dropShape(tones []note.Class, bass note.Class, nth int) []int {
+	if len(tones) < nth {
+		return closeShape(withBassFirst(tones, bass))
+	}
+	for r := range tones {
+		close := closeShape(append(append([]note.Class{}, tones[r:]...), tones[:r]...))
+		drop := append([]int{}, close...)
+		drop[len(drop)-nth] -= 12
+		sort.Ints(drop)
+		if classOfSemitone(drop[0]) == bass {
+			return drop
+		}
+	}
+	close := closeShape(tones)
+	close[len(close)-nth] -= 12
+	sort.Ints(close)
+	return bassBelow(bass, close)
+}
+
+// bassBelow a shape, in the nearest octave beneath its lowest voice
+func bassBelow(bass note.Class, upper []int) []int {
+	b := semitoneOf(bass)
+	for b+12 < upper[0] {
+		b += 12
+	}
+	for b >= upper[0] {
+		b -= 12
+	}
+	return append([]int{b}, upper...)
+}
+
+// withBassFirst rotates the tones to begin with the bass, or adds the bass before the tones if it is not a chord tone
+func withBassFirst(tones []note.Class, bass note.Class) []note.Class {
+	for i, t := range tones {
+		if t == bass {
+			return append(append([]note.Class{}, tones[i:]...), tones[:i]...)
+		}
+	}
+	return append([]note.Class{bass}, tones...)
+}
+
+// without the given class
+func without(tones []note.Class, class note.Class) (others []note.Class) {
+	for _, t := range tones {
+		if t != class {
+			others = append(others, t)
+		}
+	}
+	return
+}
+
+// nextAbove the given pitch, the next pitch of the given class
+func nextAbove(pitch int, class note.Class) int {
+	p := pitch - ((pitch%12)+12)%12 + semitoneOf(class)
+	for p <= pitch {
+		p += 12
+	}
+	return p
+}
+
+// semitoneOf a chromatic class above C, from 0 to 11
+func semitoneOf(class note.Class) int {
+	return (note.C.Diff(class) + 12) % 12
+}
+
+// classOfSemitone of any pitch, modulo octaves
+func classOfSemitone(pitch int) note.Class {
+	class, _ := note.C.Step(((pitch % 12) + 12) % 12)
+	return class
+}
+
+// notesOfMIDI note numbers, e.g. 60 is C4
+func notesOfMIDI(pitches []int) (notes []*note.Note) {
+	for _, p := range pitches {
+		notes = append(notes, &note.Note{Class: classOfSemitone(p), Octave: note.Octave(int(math.Floor(float64(p)/12)) - 1)})
+	}
+	return
+}
+
+// meanOf the pitches
+func meanOf(pitches []int) float64 {
+	sum := 0
+	for _, p := range pitches {
+		sum += p
+	}
+	return float64(sum) / float64(len(pitches))
+}
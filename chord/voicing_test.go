@@ -0,0 +1,82 @@
+// Chords are voiced into notes placed in octaves, e.g. Cmaj7 in Drop 2 voicing is C4 G4 B4 E5, according to a Technique, inversion, register range and maximum span.
+package chord
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestVoice(t *testing.T) {
+	assertVoice(t, "C4 E4 G4", "C", Voicing{})
+	assertVoice(t, "C4 E4 G4", "C", Voicing{Technique: BlockTechnique})
+	assertVoice(t, "E4 G4 C5", "C", Voicing{Inversion: 1})
+	assertVoice(t, "G4 C5 E5", "C", Voicing{Inversion: 2})
+	assertVoice(t, "E4 G4 C5", "C/E", Voicing{Inversion: 2})
+	assertVoice(t, "C4 G4 E5", "C", Voicing{Technique: OpenTechnique})
+	assertVoice(t, "C4 G4 B4 E5", "Cmaj7", Voicing{Technique: Drop2Technique})
+	assertVoice(t, "C4 B4 E5 G5", "Cmaj7", Voicing{Technique: Drop3Technique})
+	assertVoice(t, "E4 C5 G5", "C/E", Voicing{Technique: Drop2Technique})
+	assertVoice(t, "C3 E4 G4 B4", "Cmaj7", Voicing{Technique: SpreadTechnique})
+	assertVoice(t, "C4 G4 C5", "C", Voicing{Technique: PowerTechnique})
+	assertVoice(t, "E3 C4 E4 G4", "C/E", Voicing{Technique: SlashTechnique})
+	assertVoice(t, "E3 B3 D4 G#4", "E7", Voicing{Technique: GuitarTechnique})
+	assertVoice(t, "D4 F4 A4 C5", "Dm7", Voicing{Technique: ChordioidTechnique})
+}
+
+func TestVoice_Register(t *testing.T) {
+	assertVoice(t, "C2 E2 G2", "C", Voicing{Low: note.Named("A1"), High: note.Named("A2")})
+	assertVoice(t, "E5 G5 C6", "C", Voicing{Inversion: 1, Low: note.Named("E5"), High: note.Named("C6")})
+	_, err := Of("C").Voice(Voicing{Low: note.Named("C4"), High: note.Named("F4")})
+	assert.Equal(t, ErrRegister, err)
+}
+
+func TestVoice_MaxSpan(t *testing.T) {
+	assertVoice(t, "C4 E4 G4 B4", "Cmaj7", Voicing{MaxSpan: 12})
+	_, err := Of("Cmaj7").Voice(Voicing{Technique: Drop2Technique, MaxSpan: 12})
+	assert.Equal(t, ErrSpan, err)
+}
+
+func TestVoice_Inversion(t *testing.T) {
+	_, err := Of("C").Voice(Voicing{Inversion: 3})
+	assert.Equal(t, ErrInversion, err)
+	_, err = Of("C").Voice(Voicing{Inversion: -1})
+	assert.Equal(t, ErrInversion, err)
+}
+
+func TestVoice_BassIsLowest(t *testing.T) {
+	for _, name := range []string{"C/E", "Cmaj7/B", "Am7/G", "F/G", "D/F#", "C7/Bb"} {
+		c := Of(name)
+		for _, technique := range []Technique{GenericTechnique, BlockTechnique, ChordioidTechnique, GuitarTechnique, OpenTechnique, PowerTechnique, SlashTechnique, Drop2Technique, Drop3Technique, SpreadTechnique} {
+			notes, err := c.Voice(Voicing{Technique: technique})
+			if assert.Nil(t, err, name) {
+				assert.Equal(t, c.Bass, notes[0].Class, fmt.Sprintf("%s technique %d", name, technique))
+				for i := 1; i < len(notes); i++ {
+					assert.True(t, notes[i].MIDI() > notes[i-1].MIDI(), fmt.Sprintf("%s technique %d", name, technique))
+				}
+			}
+		}
+	}
+}
+
+//
+// Private
+//
+
+func assertVoice(t *testing.T, expect string, name string, v Voicing) {
+	notes, err := Of(name).Voice(v)
+	assert.Nil(t, err, name)
+	assert.Equal(t, expect, notesString(notes), name)
+}
+
+func notesString(notes []*note.Note) string {
+	var names []string
+	for _, n := range notes {
+		names = append(names, fmt.Sprintf("%s%d", n.Class.String(note.Sharp), n.Octave))
+	}
+	return strings.Join(names, " ")
+}
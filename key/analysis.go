@@ -0,0 +1,338 @@
+// Chords are analysed in a key by Roman numeral, e.g. in C major, G7 is V7, Bdim is vii°, Ab is bVI, D is V/V and Db/F is N6.
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Analysis of a chord in a key, as a Roman numeral and its harmonic Function
+type Analysis struct {
+	Numeral  string         // Roman numeral, e.g. "V7", "ii°", "bVI", "V/V" or "N6"
+	Function chord.Function // Harmonic function, e.g. DominantDiatonic or BorrowedAltered
+}
+
+// Analyze a chord in the key, as a diatonic, secondary, Neapolitan or borrowed chord, by Roman numeral and Function.
+// Upper case numerals are major (or augmented) and lower case are minor (or diminished), with figures for sevenths and inversions, e.g. "V6/5" is a dominant seventh with its third in the bass.
+// A chord that is none of these is named by the Roman numeral of its root, with GenericFunction.
+func (this Key) Analyze(c chord.Chord) Analysis {
+	if !this.Root.IsChromatic() || !c.Root.IsChromatic() {
+		return Analysis{}
+	}
+	q := qualityOf(c)
+	degree := semitonesAbove(this.Root, c.Root)
+	diatonic := this.diatonicSets()
+
+	// diatonic to the key
+	if isWithinAny(c, this.Root, diatonic) {
+		return Analysis{
+			Numeral:  this.numeralOf(degree, q) + q.figure(inversionOf(c)),
+			Function: diatonicFunction(degree),
+		}
+	}
+
+	// Neapolitan: a major triad on the lowered second degree, usually in first inversion, where it is not already diatonic, e.g. in a Phrygian or Locrian key
+	if degree == 1 && q.triad == majorTriad && q.seventh == noSeventh {
+		return Analysis{Numeral: "N" + triadInversionFigures[inversionOf(c)%len(triadInversionFigures)], Function: chord.NeapolitanAltered}
+	}
+
+	// secondary dominant or leading-tone chord, tonicizing a major or minor diatonic triad other than the tonic
+	if numeral, function, ok := this.secondary(c, q); ok {
+		return Analysis{Numeral: numeral, Function: function}
+	}
+
+	// borrowed from the parallel key
+	if isWithinAny(c, this.Root, this.parallel().diatonicSets()) {
+		return Analysis{
+			Numeral:  this.numeralOf(degree, q) + q.figure(inversionOf(c)),
+			Function: chord.BorrowedAltered,
+		}
+	}
+
+	return Analysis{Numeral: this.numeralOf(degree, q) + q.figure(inversionOf(c)), Function: chord.GenericFunction}
+}
+
+//
+// Private
+//
+
+// Semitones of the scale of each key mode above its tonic
+var modeSemitones = map[Mode][]int{
+	Major:         {0, 2, 4, 5, 7, 9, 11},
+	Minor:         {0, 2, 3, 5, 7, 8, 10},
+	Dorian:        {0, 2, 3, 5, 7, 9, 10},
+	Phrygian:      {0, 1, 3, 5, 7, 8, 10},
+	Lydian:        {0, 2, 4, 6, 7, 9, 11},
+	Mixolydian:    {0, 2, 4, 5, 7, 9, 10},
+	Locrian:       {0, 1, 3, 5, 6, 8, 10},
+	HarmonicMinor: {0, 2, 3, 5, 7, 8, 11},
+	MelodicMinor:  {0, 2, 3, 5, 7, 9, 11},
+}
+
+// Roman numeral of the degree of the key for each semitone above its tonic, with accidentals for chromatic degrees; other modes are numbered as the major or minor key of the same third
+var modeDegreeNumerals = map[Mode][12]string{
+	Major: {"I", "bII", "II", "bIII", "III", "IV", "#IV", "V", "bVI", "VI", "bVII", "VII"},
+	Minor: {"I", "bII", "II", "III", "#III", "IV", "#IV", "V", "VI", "#VI", "VII", "#VII"},
+}
+
+// diatonicSets of semitones above the tonic; a minor key is also diatonic to its harmonic minor, with a raised leading tone
+func (this Key) diatonicSets() [][]int {
+	switch this.Mode {
+	case Minor:
+		return [][]int{modeSemitones[Minor], modeSemitones[HarmonicMinor]}
+	case MelodicMinor:
+		return [][]int{modeSemitones[MelodicMinor], modeSemitones[Minor]}
+	}
+	if semitones, ok := modeSemitones[this.Mode]; ok {
+		return [][]int{semitones}
+	}
+	return [][]int{modeSemitones[Major]}
+}
+
+// degreeNumeral of the key for a root some semitones above its tonic
+func (this Key) degreeNumeral(semitones int) string {
+	numerals := modeDegreeNumerals[Major]
+	if this.Mode.isMinor() {
+		numerals = modeDegreeNumerals[Minor]
+	}
+	return numerals[semitones]
+}
+
+// numeralOf a chord of the given quality, with its root some semitones above the tonic; the diminished triad on the raised leading tone of a minor key is vii°, as in its harmonic minor
+func (this Key) numeralOf(semitones int, q chordQuality) string {
+	if this.Mode.isMinor() && semitones == 11 && q.triad == diminishedTriad {
+		return "vii"
+	}
+	return q.numeral(this.degreeNumeral(semitones))
+}
+
+// parallel major or minor key, e.g. C minor for C major, or C major for C dorian
+func (this Key) parallel() Key {
+	if this.Mode.isMinor() {
+		return this.Parallel(Major)
+	}
+	return this.Parallel(Minor)
+}
+
+// secondary dominant (V/x) or leading-tone chord (vii°/x) tonicizing a major or minor diatonic triad other than the tonic
+func (this Key) secondary(c chord.Chord, q chordQuality) (string, chord.Function, bool) {
+	sets := this.diatonicSets()
+	for _, target := range sets[0][1:] {
+		targetRoot, _ := this.Root.Step(target)
+		targetQuality := diatonicTargetQuality(sets, target)
+		if targetQuality.triad != majorTriad && targetQuality.triad != minorTriad {
+			continue
+		}
+		targetNumeral := "/" + targetQuality.numeral(this.degreeNumeral(target))
+		switch semitonesAbove(targetRoot, c.Root) {
+		case 7:
+			if q.triad == majorTriad && (q.seventh == noSeventh || q.seventh == minorSeventh) {
+				return "V" + q.figure(inversionOf(c)) + targetNumeral, chord.SecondaryDominantAltered, true
+			}
+		case 11:
+			if q.triad == diminishedTriad {
+				return "vii" + q.figure(inversionOf(c)) + targetNumeral, chord.SecondaryLeadingToneAltered, true
+			}
+		}
+	}
+	return "", chord.GenericFunction, false
+}
+
+// diatonicTargetQuality of the triad on the degree some semitones above the tonic, major if it is major in any of the diatonic sets, e.g. the V of a minor key, as in its harmonic minor
+func diatonicTargetQuality(sets [][]int, target int) chordQuality {
+	quality := qualityOfSemitones(diatonicTriad(sets[0], target))
+	for _, set := range sets[1:] {
+		if q := qualityOfSemitones(diatonicTriad(set, target)); q.triad == majorTriad {
+			return q
+		}
+	}
+	return quality
+}
+
+// diatonicTriad of the scale, on the degree some semitones above the tonic, as semitones above its root
+func diatonicTriad(scale []int, root int) (triad []int) {
+	for i, s := range scale {
+		if s == root {
+			for _, step := range []int{0, 2, 4} {
+				triad = append(triad, ((scale[(i+step)%len(scale)]-root)%12+12)%12)
+			}
+		}
+	}
+	return
+}
+
+// diatonicFunction of the degree some semitones above the tonic
+func diatonicFunction(semitones int) chord.Function {
+	switch semitones {
+	case 0:
+		return chord.TonicDiatonic
+	case 1, 2:
+		return chord.SupertonicDiatonic
+	case 3, 4:
+		return chord.MediantDiatonic
+	case 5:
+		return chord.SubdominantDiatonic
+	case 7:
+		return chord.DominantDiatonic
+	case 8, 9:
+		return chord.SubmediantDiatonic
+	case 10:
+		return chord.SubtonicDiatonic
+	case 11:
+		return chord.LeadingDiatonic
+	}
+	return chord.GenericFunction
+}
+
+// isWithinAny of the sets of semitones above the tonic, are all the tones of the chord
+func isWithinAny(c chord.Chord, tonic note.Class, sets [][]int) bool {
+	for _, set := range sets {
+		if isWithin(c, tonic, set) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWithin(c chord.Chord, tonic note.Class, set []int) bool {
+	in := make(map[int]bool)
+	for _, s := range set {
+		in[s] = true
+	}
+	for _, class := range c.Tones {
+		if !class.IsChromatic() || !in[semitonesAbove(tonic, class)] {
+			return false
+		}
+	}
+	if c.Bass != note.Nil && (!c.Bass.IsChromatic() || !in[semitonesAbove(tonic, c.Bass)]) {
+		return false
+	}
+	return true
+}
+
+// Triad quality of a chord
+type triadQuality int
+
+const (
+	otherTriad triadQuality = iota
+	majorTriad
+	minorTriad
+	diminishedTriad
+	augmentedTriad
+)
+
+// Seventh of a chord
+type seventhQuality int
+
+const (
+	noSeventh seventhQuality = iota
+	minorSeventh
+	majorSeventh
+	diminishedSeventh
+)
+
+// chordQuality of the triad and seventh of a chord
+type chordQuality struct {
+	triad   triadQuality
+	seventh seventhQuality
+}
+
+func qualityOf(c chord.Chord) chordQuality {
+	var semitones []int
+	for _, class := range c.Tones {
+		if class.IsChromatic() {
+			semitones = append(semitones, semitonesAbove(c.Root, class))
+		}
+	}
+	return qualityOfSemitones(semitones)
+}
+
+func qualityOfSemitones(semitones []int) (q chordQuality) {
+	has := make(map[int]bool)
+	for _, s := range semitones {
+		has[s] = true
+	}
+	switch {
+	case has[4] && has[7]:
+		q.triad = majorTriad
+	case has[3] && has[7]:
+		q.triad = minorTriad
+	case has[3] && has[6]:
+		q.triad = diminishedTriad
+	case has[4] && has[8]:
+		q.triad = augmentedTriad
+	}
+	switch {
+	case q.triad == diminishedTriad && has[9] && !has[10]:
+		q.seventh = diminishedSeventh
+	case has[10]:
+		q.seventh = minorSeventh
+	case has[11]:
+		q.seventh = majorSeventh
+	}
+	return
+}
+
+// numeral of the degree, in upper case for major or augmented and lower case for minor or diminished, e.g. "ii" or "bVI" or "#iv"
+func (q chordQuality) numeral(degree string) string {
+	switch q.triad {
+	case minorTriad, diminishedTriad:
+		return lowerNumeral(degree)
+	}
+	return degree
+}
+
+// figure after the numeral, for the quality, seventh and inversion, e.g. "°", "+", "7", "ø6/5" or "6/4"
+func (q chordQuality) figure(inversion int) string {
+	symbol := ""
+	switch q.triad {
+	case diminishedTriad:
+		symbol = "°"
+		if q.seventh == minorSeventh {
+			symbol = "ø"
+		}
+	case augmentedTriad:
+		symbol = "+"
+	}
+	if q.seventh == majorSeventh {
+		symbol += "maj"
+	}
+	if q.seventh == noSeventh {
+		return symbol + triadInversionFigures[inversion%len(triadInversionFigures)]
+	}
+	return symbol + seventhInversionFigures[inversion%len(seventhInversionFigures)]
+}
+
+var (
+	triadInversionFigures   = []string{"", "6", "6/4"}
+	seventhInversionFigures = []string{"7", "6/5", "4/3", "4/2"}
+)
+
+// inversionOf a chord by its slash bass: 0 for root position, 1 for the third in the bass, 2 for the fifth, 3 for the seventh
+func inversionOf(c chord.Chord) int {
+	if c.Bass == note.Nil || c.Bass == c.Root {
+		return 0
+	}
+	for inversion, interval := range []chord.Interval{chord.I3, chord.I5, chord.I7} {
+		if class, ok := c.Tones[interval]; ok && class == c.Bass {
+			return inversion + 1
+		}
+	}
+	return 0
+}
+
+// lowerNumeral in lower case, keeping any accidental, e.g. "bVI" becomes "bvi"
+func lowerNumeral(numeral string) string {
+	lower := []byte(numeral)
+	for i, b := range lower {
+		if b >= 'A' && b <= 'Z' {
+			lower[i] = b + 'a' - 'A'
+		}
+	}
+	return string(lower)
+}
+
+// semitonesAbove the given class, from 0 to 11
+func semitonesAbove(from note.Class, to note.Class) int {
+	return (from.Diff(to) + 12) % 12
+}
@@ -0,0 +1,92 @@
+// Chords are analysed in a key by Roman numeral, e.g. in C major, G7 is V7, Bdim is vii°, Ab is bVI, D is V/V and Db/F is N6.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+)
+
+func TestAnalyze_Diatonic(t *testing.T) {
+	assertAnalysis(t, "C", "C", "I", chord.TonicDiatonic)
+	assertAnalysis(t, "C", "Dm", "ii", chord.SupertonicDiatonic)
+	assertAnalysis(t, "C", "Em", "iii", chord.MediantDiatonic)
+	assertAnalysis(t, "C", "F", "IV", chord.SubdominantDiatonic)
+	assertAnalysis(t, "C", "G", "V", chord.DominantDiatonic)
+	assertAnalysis(t, "C", "Am", "vi", chord.SubmediantDiatonic)
+	assertAnalysis(t, "C", "Bdim", "vii°", chord.LeadingDiatonic)
+	assertAnalysis(t, "C", "G7", "V7", chord.DominantDiatonic)
+	assertAnalysis(t, "C", "Dm7", "ii7", chord.SupertonicDiatonic)
+	assertAnalysis(t, "C", "Cmaj7", "Imaj7", chord.TonicDiatonic)
+	assertAnalysis(t, "C", "Bm7b5", "viiø7", chord.LeadingDiatonic)
+	assertAnalysis(t, "Eb", "Bb7", "V7", chord.DominantDiatonic)
+}
+
+func TestAnalyze_Minor(t *testing.T) {
+	assertAnalysis(t, "A minor", "Am", "i", chord.TonicDiatonic)
+	assertAnalysis(t, "A minor", "Bdim", "ii°", chord.SupertonicDiatonic)
+	assertAnalysis(t, "A minor", "C", "III", chord.MediantDiatonic)
+	assertAnalysis(t, "A minor", "Dm", "iv", chord.SubdominantDiatonic)
+	assertAnalysis(t, "A minor", "E7", "V7", chord.DominantDiatonic)
+	assertAnalysis(t, "A minor", "F", "VI", chord.SubmediantDiatonic)
+	assertAnalysis(t, "A minor", "G", "VII", chord.SubtonicDiatonic)
+	assertAnalysis(t, "A minor", "G#dim7", "vii°7", chord.LeadingDiatonic)
+	// the subtonic and the raised leading tone are distinct degrees
+	assertAnalysis(t, "C minor", "Bb", "VII", chord.SubtonicDiatonic)
+	assertAnalysis(t, "C minor", "B", "#VII", chord.GenericFunction)
+	assertAnalysis(t, "D dorian", "Db", "#VII", chord.GenericFunction)
+	assertAnalysis(t, "A minor", "Ab", "#VII", chord.GenericFunction)
+}
+
+func TestAnalyze_Inversion(t *testing.T) {
+	assertAnalysis(t, "C", "C/E", "I6", chord.TonicDiatonic)
+	assertAnalysis(t, "C", "C/G", "I6/4", chord.TonicDiatonic)
+	assertAnalysis(t, "C", "G7/B", "V6/5", chord.DominantDiatonic)
+	assertAnalysis(t, "C", "G7/D", "V4/3", chord.DominantDiatonic)
+	assertAnalysis(t, "C", "G7/F", "V4/2", chord.DominantDiatonic)
+}
+
+func TestAnalyze_Secondary(t *testing.T) {
+	assertAnalysis(t, "C", "D", "V/V", chord.SecondaryDominantAltered)
+	assertAnalysis(t, "C", "D7", "V7/V", chord.SecondaryDominantAltered)
+	assertAnalysis(t, "C", "A7", "V7/ii", chord.SecondaryDominantAltered)
+	assertAnalysis(t, "C", "E", "V/vi", chord.SecondaryDominantAltered)
+	assertAnalysis(t, "C", "C7", "V7/IV", chord.SecondaryDominantAltered)
+	assertAnalysis(t, "C", "F#dim", "vii°/V", chord.SecondaryLeadingToneAltered)
+	assertAnalysis(t, "C", "F#dim7", "vii°7/V", chord.SecondaryLeadingToneAltered)
+	assertAnalysis(t, "C", "D7/F#", "V6/5/V", chord.SecondaryDominantAltered)
+}
+
+func TestAnalyze_Borrowed(t *testing.T) {
+	assertAnalysis(t, "C", "Ab", "bVI", chord.BorrowedAltered)
+	assertAnalysis(t, "C", "Bb", "bVII", chord.BorrowedAltered)
+	assertAnalysis(t, "C", "Eb", "bIII", chord.BorrowedAltered)
+	assertAnalysis(t, "C", "Fm", "iv", chord.BorrowedAltered)
+	assertAnalysis(t, "A minor", "Bm", "ii", chord.BorrowedAltered)
+}
+
+func TestAnalyze_Neapolitan(t *testing.T) {
+	assertAnalysis(t, "C", "Db/F", "N6", chord.NeapolitanAltered)
+	assertAnalysis(t, "A minor", "Bb", "N", chord.NeapolitanAltered)
+	// the major triad on the lowered second degree is diatonic to a Phrygian or Locrian key
+	assertAnalysis(t, "E phrygian", "F", "bII", chord.SupertonicDiatonic)
+	assertAnalysis(t, "E phrygian", "F/A", "bII6", chord.SupertonicDiatonic)
+	assertAnalysis(t, "B locrian", "C", "bII", chord.SupertonicDiatonic)
+}
+
+func TestAnalyze_Other(t *testing.T) {
+	assertAnalysis(t, "C", "F#", "#IV", chord.GenericFunction)
+	assert.Equal(t, Analysis{}, Of("C").Analyze(chord.Chord{}))
+}
+
+//
+// Private
+//
+
+func assertAnalysis(t *testing.T, keyName string, chordName string, expectNumeral string, expectFunction chord.Function) {
+	a := Of(keyName).Analyze(chord.Of(chordName))
+	assert.Equal(t, expectNumeral, a.Numeral, keyName+": "+chordName)
+	assert.Equal(t, expectFunction, a.Function, keyName+": "+chordName)
+}
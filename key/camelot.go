@@ -0,0 +1,105 @@
+// The Camelot wheel and Open Key notation number the keys around the circle of fifths for harmonic mixing, each major and minor key with the same signature sharing a number, e.g. 8B and 8A for C major and A minor in Camelot, or 1d and 1m in Open Key.
+// A key mixes well into those of the same number or one number either side, its relative, dominant and subdominant keys.
+//
+// https://en.wikipedia.org/wiki/Camelot_wheel
+package key
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCamelot = errors.New("no such Camelot key")
+	ErrOpenKey = errors.New("no such Open Key")
+)
+
+// Camelot notation of the Key, from 1 to 12, with A for a minor key or B for a major key, e.g. "8B" for C major or "8A" for A minor, or "" for a key of another mode
+func (this Key) Camelot() string {
+	letter, ok := this.majorOrMinor("B", "A")
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(mod12(int(this.Signature())+7)+1) + letter
+}
+
+// OpenKey notation of the Key, from 1 to 12, with m for a minor key or d for a major key, e.g. "1d" for C major or "1m" for A minor, or "" for a key of another mode
+func (this Key) OpenKey() string {
+	letter, ok := this.majorOrMinor("d", "m")
+	if !ok {
+		return ""
+	}
+	return strconv.Itoa(this.CircleOfFifths()+1) + letter
+}
+
+// FromCamelot notation, e.g. "8B" is C major and "8A" is A minor, returning ErrCamelot if there is no such key
+func FromCamelot(code string) (Key, error) {
+	number, mode, ok := parseKeyCode(code, rgxCamelot, "b", "a")
+	if !ok {
+		return Key{}, ErrCamelot
+	}
+	return fromKeyCode(number-8, mode), nil
+}
+
+// FromOpenKey notation, e.g. "1d" is C major and "1m" is A minor, returning ErrOpenKey if there is no such key
+func FromOpenKey(code string) (Key, error) {
+	number, mode, ok := parseKeyCode(code, rgxOpenKey, "d", "m")
+	if !ok {
+		return Key{}, ErrOpenKey
+	}
+	return fromKeyCode(number-1, mode), nil
+}
+
+//
+// Private
+//
+
+var (
+	rgxCamelot = regexp.MustCompile("^([0-9]+)([ab])$")
+	rgxOpenKey = regexp.MustCompile("^([0-9]+)([dm])$")
+)
+
+// majorOrMinor letter of the Key, or false if it is of neither the major nor a minor mode
+func (this Key) majorOrMinor(major string, minor string) (string, bool) {
+	if !this.Root.IsChromatic() {
+		return "", false
+	}
+	switch this.Mode {
+	case Major:
+		return major, true
+	case Minor, HarmonicMinor, MelodicMinor:
+		return minor, true
+	}
+	return "", false
+}
+
+// parseKeyCode of a number from 1 to 12 and a letter for a major or minor key, e.g. "8B"
+func parseKeyCode(code string, rgx *regexp.Regexp, major string, minor string) (number int, mode Mode, ok bool) {
+	match := rgx.FindStringSubmatch(strings.ToLower(strings.TrimSpace(code)))
+	if match == nil {
+		return
+	}
+	number, _ = strconv.Atoi(match[1])
+	if number < 1 || number > 12 {
+		return
+	}
+	switch match[2] {
+	case major:
+		mode = Major
+	case minor:
+		mode = Minor
+	}
+	ok = true
+	return
+}
+
+// fromKeyCode is the key of the given mode some fifths above (or below) C major, spelled with at most six sharps or five flats, e.g. Db major rather than C# major
+func fromKeyCode(fifths int, mode Mode) Key {
+	fifths = mod12(fifths)
+	if fifths > 6 {
+		fifths -= 12
+	}
+	return FromSignature(Signature(fifths), mode)
+}
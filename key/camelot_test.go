@@ -0,0 +1,94 @@
+// The Camelot wheel and Open Key notation number the keys around the circle of fifths for harmonic mixing, each major and minor key with the same signature sharing a number, e.g. 8B and 8A for C major and A minor in Camelot, or 1d and 1m in Open Key.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestKey_Camelot(t *testing.T) {
+	for name, expect := range map[string]string{
+		"C":                "8B",
+		"G":                "9B",
+		"E":                "12B",
+		"B":                "1B",
+		"F# major":         "2B",
+		"Db":               "3B",
+		"F":                "7B",
+		"A minor":          "8A",
+		"E minor":          "9A",
+		"D minor":          "7A",
+		"G# minor":         "1A",
+		"A harmonic minor": "8A",
+		"D dorian":         "",
+	} {
+		assert.Equal(t, expect, Of(name).Camelot(), name)
+	}
+	assert.Equal(t, "", Key{}.Camelot())
+}
+
+func TestKey_OpenKey(t *testing.T) {
+	for name, expect := range map[string]string{
+		"C":        "1d",
+		"G":        "2d",
+		"F":        "12d",
+		"A minor":  "1m",
+		"E minor":  "2m",
+		"D minor":  "12m",
+		"D dorian": "",
+	} {
+		assert.Equal(t, expect, Of(name).OpenKey(), name)
+	}
+}
+
+func TestFromCamelot(t *testing.T) {
+	for code, expect := range map[string]Key{
+		"8B":  {Root: note.C, AdjSymbol: note.Sharp, Mode: Major},
+		"8A":  {Root: note.A, AdjSymbol: note.Flat, Mode: Minor},
+		"12b": {Root: note.E, AdjSymbol: note.Sharp, Mode: Major},
+		"3B":  {Root: note.Cs, AdjSymbol: note.Flat, Mode: Major},
+		"2B":  {Root: note.Fs, AdjSymbol: note.Sharp, Mode: Major},
+		"4A":  {Root: note.F, AdjSymbol: note.Flat, Mode: Minor},
+	} {
+		k, err := FromCamelot(code)
+		assert.Nil(t, err, code)
+		assert.Equal(t, expect, k, code)
+	}
+	for _, code := range []string{"", "13B", "0A", "8C", "8d", "B8"} {
+		_, err := FromCamelot(code)
+		assert.Equal(t, ErrCamelot, err, code)
+	}
+}
+
+func TestFromOpenKey(t *testing.T) {
+	for code, expect := range map[string]Key{
+		"1d":  {Root: note.C, AdjSymbol: note.Sharp, Mode: Major},
+		"1m":  {Root: note.A, AdjSymbol: note.Flat, Mode: Minor},
+		"12D": {Root: note.F, AdjSymbol: note.Flat, Mode: Major},
+	} {
+		k, err := FromOpenKey(code)
+		assert.Nil(t, err, code)
+		assert.Equal(t, expect, k, code)
+	}
+	for _, code := range []string{"", "13d", "1B", "8A"} {
+		_, err := FromOpenKey(code)
+		assert.Equal(t, ErrOpenKey, err, code)
+	}
+}
+
+func TestCamelot_RoundTrip(t *testing.T) {
+	for s := Flats(5); s <= Sharps(6); s++ {
+		for _, mode := range []Mode{Major, Minor} {
+			k := FromSignature(s, mode)
+			camelot, err := FromCamelot(k.Camelot())
+			assert.Nil(t, err)
+			assert.Equal(t, k, camelot)
+			openKey, err := FromOpenKey(k.OpenKey())
+			assert.Nil(t, err)
+			assert.Equal(t, k, openKey)
+		}
+	}
+}
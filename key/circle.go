@@ -0,0 +1,57 @@
+// The circle of fifths orders the keys by their signatures, each a fifth above the last, e.g. C G D A E B F# Db Ab Eb Bb F, with the relative minor keys A E B F# C# G# D# Bb F C G D alongside.
+// The keys closest to a key are its neighbors: its dominant and subdominant a fifth above and below it, its relative key with the same signature, and its parallel key with the same tonic.
+//
+// https://en.wikipedia.org/wiki/Circle_of_fifths
+package key
+
+// CircleOfFifths position of the Key, counted clockwise in fifths from C major or A minor, from 0 to 11, e.g. 1 for G major or E minor, or 11 for F major or D minor
+func (this Key) CircleOfFifths() int {
+	return mod12(int(this.Signature()))
+}
+
+// Dominant key a fifth above, of the same mode, e.g. G major for C major, or E minor for A minor
+func (this Key) Dominant() Key {
+	if !this.Root.IsChromatic() {
+		return Key{}
+	}
+	return fromFifths(int(this.Signature())+1, this.Mode)
+}
+
+// Subdominant key a fifth below, of the same mode, e.g. F major for C major, or D minor for A minor
+func (this Key) Subdominant() Key {
+	if !this.Root.IsChromatic() {
+		return Key{}
+	}
+	return fromFifths(int(this.Signature())-1, this.Mode)
+}
+
+// Neighbors of the Key on the circle of fifths: its dominant, subdominant, relative and parallel keys, e.g. G major, F major, A minor and C minor for C major
+func (this Key) Neighbors() []Key {
+	if !this.Root.IsChromatic() {
+		return nil
+	}
+	relative := this.Relative(Minor)
+	if this.Mode.isMinor() {
+		relative = this.Relative(Major)
+	}
+	return []Key{this.Dominant(), this.Subdominant(), relative, this.parallel()}
+}
+
+//
+// Private
+//
+
+// fromFifths is the key of the given mode whose signature is some fifths above (or below) C major, spelled with at most seven sharps or flats, e.g. 8 is the 4 flats of Ab major
+func fromFifths(fifths int, mode Mode) Key {
+	switch {
+	case fifths > 7:
+		fifths -= 12
+	case fifths < -7:
+		fifths += 12
+	}
+	return FromSignature(Signature(fifths), mode)
+}
+
+func mod12(i int) int {
+	return (i%12 + 12) % 12
+}
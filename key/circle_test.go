@@ -0,0 +1,66 @@
+// The circle of fifths orders the keys by their signatures, each a fifth above the last, e.g. C G D A E B F# Db Ab Eb Bb F, with the relative minor keys A E B F# C# G# D# Bb F C G D alongside.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestKey_CircleOfFifths(t *testing.T) {
+	for name, expect := range map[string]int{
+		"C":        0,
+		"G":        1,
+		"E":        4,
+		"F# major": 6,
+		"Gb":       6,
+		"Db":       7,
+		"F":        11,
+		"A minor":  0,
+		"E minor":  1,
+		"D minor":  11,
+		"D dorian": 0,
+	} {
+		assert.Equal(t, expect, Of(name).CircleOfFifths(), name)
+	}
+}
+
+func TestKey_Dominant(t *testing.T) {
+	assert.Equal(t, Key{Root: note.G, AdjSymbol: note.Sharp, Mode: Major}, Of("C").Dominant())
+	assert.Equal(t, Key{Root: note.E, AdjSymbol: note.Sharp, Mode: Minor}, Of("A minor").Dominant())
+	assert.Equal(t, Key{Root: note.F, AdjSymbol: note.Flat, Mode: Major}, Of("Bb").Dominant())
+	assert.Equal(t, Key{Root: note.Gs, AdjSymbol: note.Flat, Mode: Major}, Of("C# major").Dominant())
+	assert.Equal(t, Key{Root: note.A, AdjSymbol: note.Sharp, Mode: Dorian}, Of("D dorian").Dominant())
+	assert.Equal(t, Key{}, Key{}.Dominant())
+}
+
+func TestKey_Subdominant(t *testing.T) {
+	assert.Equal(t, Key{Root: note.F, AdjSymbol: note.Flat, Mode: Major}, Of("C").Subdominant())
+	assert.Equal(t, Key{Root: note.D, AdjSymbol: note.Flat, Mode: Minor}, Of("A minor").Subdominant())
+	assert.Equal(t, Key{Root: note.E, AdjSymbol: note.Sharp, Mode: Major}, Of("Cb").Subdominant())
+	assert.Equal(t, Key{}, Key{}.Subdominant())
+}
+
+func TestKey_Neighbors(t *testing.T) {
+	assertNeighbors(t, "G Major, F Major, A Minor, C Minor", "C")
+	assertNeighbors(t, "E Minor, D Minor, C Major, A Major", "A minor")
+	assertNeighbors(t, "A Major, G Major, B Minor, D Minor", "D major")
+	assert.Nil(t, Key{}.Neighbors())
+}
+
+//
+// Private
+//
+
+func assertNeighbors(t *testing.T, expect string, name string) {
+	actual := ""
+	for i, k := range Of(name).Neighbors() {
+		if i > 0 {
+			actual += ", "
+		}
+		actual += k.Root.String(k.AdjSymbol) + " " + k.Mode.String()
+	}
+	assert.Equal(t, expect, actual, name)
+}
@@ -0,0 +1,92 @@
+// The distance between two keys measures how closely they are related, e.g. by the steps between their signatures around the circle of fifths, by the tones their scales share, or by Lerdahl's regional distance in tonal pitch space.
+//
+// Fred Lerdahl, Tonal Pitch Space, Oxford University Press, 2001
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// FifthsDistance to another Key, the fewest steps around the circle of fifths between their signatures, from 0 to 6, e.g. 1 from C major to G major, 0 from C major to A minor, or 3 from C major to C minor
+func (this Key) FifthsDistance(other Key) int {
+	d := mod12(other.CircleOfFifths() - this.CircleOfFifths())
+	if d > 6 {
+		return 12 - d
+	}
+	return d
+}
+
+// SharedTones of the scales of the Key and another Key, from 0 to 7, e.g. 6 for C major and G major, or 7 for C major and A minor
+func (this Key) SharedTones(other Key) (shared int) {
+	in := this.pitchClasses()
+	for class := range other.pitchClasses() {
+		if in[class] {
+			shared++
+		}
+	}
+	return
+}
+
+// RegionalDistance to another Key, Lerdahl's distance δ = i + j + k between the tonic chords of the two keys, in which i is their FifthsDistance,
+// j is the steps around the circle of fifths between the roots of their tonic chords, and k is the number of pitch classes in the basic space of the other key's tonic chord that are not in this one's.
+// e.g. 7 from C major to each of G major, F major, A minor and C minor, or 0 from a key to itself.
+func (this Key) RegionalDistance(other Key) int {
+	if !this.Root.IsChromatic() || !other.Root.IsChromatic() {
+		return 0
+	}
+	i := this.FifthsDistance(other)
+	j := mod12(7 * semitonesAbove(this.Root, other.Root))
+	if j > 6 {
+		j = 12 - j
+	}
+	k := 0
+	from := this.basicSpace()
+	to := other.basicSpace()
+	for level := range to {
+		for class := range to[level] {
+			if !from[level][class] {
+				k++
+			}
+		}
+	}
+	return i + j + k
+}
+
+//
+// Private
+//
+
+// pitchClasses of the scale of the Key
+func (this Key) pitchClasses() map[note.Class]bool {
+	classes := make(map[note.Class]bool)
+	if !this.Root.IsChromatic() {
+		return classes
+	}
+	semitones, ok := modeSemitones[this.Mode]
+	if !ok {
+		semitones = modeSemitones[Major]
+	}
+	for _, s := range semitones {
+		class, _ := this.Root.Step(s)
+		classes[class] = true
+	}
+	return classes
+}
+
+// basicSpace of the tonic chord of the Key, Lerdahl's levels of its root, its root and fifth, its triad, and its scale; the chromatic level is the same for every key, and so is left out
+func (this Key) basicSpace() [4]map[note.Class]bool {
+	var space [4]map[note.Class]bool
+	semitones, ok := modeSemitones[this.Mode]
+	if !ok {
+		semitones = modeSemitones[Major]
+	}
+	for level, degrees := range [][]int{{0}, {0, 4}, {0, 2, 4}} {
+		space[level] = make(map[note.Class]bool)
+		for _, d := range degrees {
+			class, _ := this.Root.Step(semitones[d])
+			space[level][class] = true
+		}
+	}
+	space[3] = this.pitchClasses()
+	return space
+}
@@ -0,0 +1,43 @@
+// The distance between two keys measures how closely they are related, e.g. by the steps between their signatures around the circle of fifths, by the tones their scales share, or by Lerdahl's regional distance in tonal pitch space.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestKey_FifthsDistance(t *testing.T) {
+	assert.Equal(t, 0, Of("C").FifthsDistance(Of("C")))
+	assert.Equal(t, 1, Of("C").FifthsDistance(Of("G")))
+	assert.Equal(t, 1, Of("C").FifthsDistance(Of("F")))
+	assert.Equal(t, 0, Of("C").FifthsDistance(Of("A minor")))
+	assert.Equal(t, 3, Of("C").FifthsDistance(Of("C minor")))
+	assert.Equal(t, 6, Of("C").FifthsDistance(Of("F# major")))
+	assert.Equal(t, 6, Of("C").FifthsDistance(Of("Gb")))
+	assert.Equal(t, 5, Of("C").FifthsDistance(Of("Db")))
+	assert.Equal(t, 2, Of("E minor").FifthsDistance(Of("F")))
+}
+
+func TestKey_SharedTones(t *testing.T) {
+	assert.Equal(t, 7, Of("C").SharedTones(Of("C")))
+	assert.Equal(t, 7, Of("C").SharedTones(Of("A minor")))
+	assert.Equal(t, 7, Of("C").SharedTones(Of("D dorian")))
+	assert.Equal(t, 6, Of("C").SharedTones(Of("G")))
+	assert.Equal(t, 4, Of("C").SharedTones(Of("C minor")))
+	assert.Equal(t, 6, Of("A minor").SharedTones(Of("A harmonic minor")))
+	assert.Equal(t, 2, Of("C").SharedTones(Of("F# major")))
+	assert.Equal(t, 0, Of("C").SharedTones(Key{}))
+}
+
+func TestKey_RegionalDistance(t *testing.T) {
+	assert.Equal(t, 0, Of("C").RegionalDistance(Of("C")))
+	assert.Equal(t, 7, Of("C").RegionalDistance(Of("G")))
+	assert.Equal(t, 7, Of("C").RegionalDistance(Of("F")))
+	assert.Equal(t, 7, Of("C").RegionalDistance(Of("A minor")))
+	assert.Equal(t, 7, Of("C").RegionalDistance(Of("C minor")))
+	assert.Equal(t, 7, Of("A minor").RegionalDistance(Of("C")))
+	assert.Equal(t, 12, Of("C").RegionalDistance(Of("D")))
+	assert.True(t, Of("C").RegionalDistance(Of("F# major")) > Of("C").RegionalDistance(Of("D")))
+	assert.Equal(t, 0, Of("C").RegionalDistance(Key{}))
+}
@@ -2,7 +2,9 @@ package key_test
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/go-music-theory/music-theory/chord"
 	"github.com/go-music-theory/music-theory/key"
 	"github.com/go-music-theory/music-theory/note"
 )
@@ -49,6 +51,48 @@ func ExampleKey_RelativeMinor() {
 	// Output: C Major -> A Minor
 }
 
+// ExampleKey_Relative demonstrates the relative and parallel keys of a mode
+func ExampleKey_Relative() {
+	k := key.Of("D dorian")
+	rel := k.Relative(key.Ionian)
+	par := k.Parallel(key.Mixolydian)
+
+	fmt.Printf("%s %s -> %s %s\n",
+		k.Root.String(k.AdjSymbol), k.Mode,
+		rel.Root.String(rel.AdjSymbol), rel.Mode)
+	fmt.Printf("%s %s -> %s %s\n",
+		k.Root.String(k.AdjSymbol), k.Mode,
+		par.Root.String(par.AdjSymbol), par.Mode)
+
+	// Output:
+	// D Dorian -> C Major
+	// D Dorian -> D Mixolydian
+}
+
+// ExampleKey_Neighbors demonstrates the closest keys on the circle of fifths, and their distances
+func ExampleKey_Neighbors() {
+	c := key.Of("C major")
+	for _, k := range c.Neighbors() {
+		fmt.Printf("%s %s: %s, %d shared tones, regional distance %d\n",
+			k.Root.String(k.AdjSymbol), k.Mode, k.Camelot(),
+			c.SharedTones(k), c.RegionalDistance(k))
+	}
+
+	// Output:
+	// G Major: 9B, 6 shared tones, regional distance 7
+	// F Major: 7B, 6 shared tones, regional distance 7
+	// A Minor: 8A, 7 shared tones, regional distance 7
+	// C Minor: 5A, 4 shared tones, regional distance 7
+}
+
+// ExampleFromCamelot demonstrates converting Camelot notation to a key
+func ExampleFromCamelot() {
+	k, _ := key.FromCamelot("8A")
+	fmt.Printf("%s %s (%s)\n", k.Root.String(k.AdjSymbol), k.Mode, k.OpenKey())
+
+	// Output: A Minor (1m)
+}
+
 // ExampleFindKey demonstrates the Krumhansl-Schmuckler key-finding algorithm
 func ExampleFindKey() {
 	// Identify the key from a C major scale
@@ -65,3 +109,83 @@ func ExampleFindKey() {
 	// C Major
 	// A Minor
 }
+
+// ExampleKey_Analyze demonstrates analysing chords in a key by Roman numeral
+func ExampleKey_Analyze() {
+	k := key.Of("C major")
+	for _, name := range []string{"C", "Dm7", "D7/F#", "G7", "Ab", "Db/F"} {
+		fmt.Printf("%s: %s\n", name, k.Analyze(chord.Of(name)).Numeral)
+	}
+
+	// Output:
+	// C: I
+	// Dm7: ii7
+	// D7/F#: V6/5/V
+	// G7: V7
+	// Ab: bVI
+	// Db/F: N6
+}
+
+// ExampleKey_Chords demonstrates building the chords of a progression of Roman numerals in a key
+func ExampleKey_Chords() {
+	chords, _ := key.Of("Eb major").Chords("ii7 - V7/V - bVII - I6/4")
+	for _, c := range chords {
+		fmt.Println(c.Name())
+	}
+
+	// Output:
+	// Fm7
+	// F7
+	// Db
+	// Eb/Bb
+}
+
+// ExampleFromSignature demonstrates finding a key from its signature, and spelling its scale
+func ExampleFromSignature() {
+	k := key.FromSignature(key.Flats(3), key.Minor)
+	fmt.Printf("%s: %s %s\n", k.Signature(), k.Root.String(k.AdjSymbol), k.Mode)
+	fmt.Printf("Accidentals: %v\n", k.Signature().Accidentals())
+	fmt.Printf("Scale: %v\n", k.SpelledScale())
+
+	fs := key.FromSignature(key.Sharps(6), key.Major)
+	gb, _ := fs.Enharmonic()
+	fmt.Printf("%s major is %s major: %v\n", fs.Root.String(fs.AdjSymbol), gb.Root.String(gb.AdjSymbol), gb.SpelledScale())
+	// Output:
+	// 3 flats: C Minor
+	// Accidentals: [Bb Eb Ab]
+	// Scale: [C D Eb F G Ab Bb]
+	// F# major is Gb major: [Gb Ab Bb Cb Db Eb F]
+}
+
+// ExampleRankKeys demonstrates ranking all 24 keys by their correlation with the notes, to see how confident the best key is
+func ExampleRankKeys() {
+	notes := []note.Class{note.A, note.A, note.C, note.E, note.A, note.G, note.F, note.E}
+	for _, c := range key.RankKeys(notes, key.Temperley)[:3] {
+		fmt.Printf("%s %s %.2f\n", c.Key.Root.String(c.Key.AdjSymbol), c.Key.Mode, c.Coefficient)
+	}
+
+	// Output:
+	// A Minor 0.71
+	// F Major 0.66
+	// D Minor 0.57
+}
+
+// ExampleFindTimeline demonstrates finding where a piece modulates from one key to another
+func ExampleFindTimeline() {
+	var notes []*note.Note
+	for i, name := range strings.Fields("C4 D4 E4 F4 G4 A4 B4 C5 G4 E4 C4 G4 C4 E4 G4 C5 " +
+		"A4 B4 C#5 D5 E5 F#5 G#5 A5 E5 C#5 A4 E5 A4 C#5 E5 A5") {
+		n := note.Named(name)
+		n.Position = float64(i)
+		n.Duration = 1
+		notes = append(notes, n)
+	}
+
+	for _, s := range key.FindTimeline(notes, key.Window{}) {
+		fmt.Printf("%v: %s %s\n", s.Position, s.Key.Root.String(s.Key.AdjSymbol), s.Key.Mode)
+	}
+
+	// Output:
+	// 0: C Major
+	// 18: A Major
+}
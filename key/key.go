@@ -23,6 +23,15 @@ func Of(name string) Key {
 	return k
 }
 
+// Parse a key strictly, e.g. Parse("Ab minor"), returning a *note.ParseError if the root, accidental or mode is not understood
+func Parse(name string) (Key, error) {
+	k := Key{}
+	if err := k.parse(name); err != nil {
+		return Key{}, err
+	}
+	return k, nil
+}
+
 // Key is a model of a musical key signature
 type Key struct {
 	Root      note.Class
@@ -34,13 +43,26 @@ type Key struct {
 // Private
 //
 
-func (this *Key) parse(name string) {
+// parse the key from its name, building as much of the key as possible, and returning a *note.ParseError for the first part of the name that is not understood.
+func (this *Key) parse(name string) error {
+	fullName := name
+
 	// determine whether the name is "sharps" or "flats"
 	this.AdjSymbol = note.AdjSymbolOf(name)
 
 	// parse the root, and keep the remaining string
+	_, modeOffset, rootErr := note.ParseRoot(name)
 	this.Root, name = note.RootAndRemaining(name)
 
 	// parse the key mode
 	this.parseMode(name)
+
+	// report the first part of the name that is not understood
+	if rootErr != nil {
+		return rootErr
+	}
+	if len(name) > 0 && !isModeName(name) {
+		return &note.ParseError{Name: fullName, Part: note.ModePart, Offset: modeOffset}
+	}
+	return nil
 }
@@ -34,10 +34,41 @@ func TestOf_Invalid(t *testing.T) {
 	assert.Equal(t, note.Nil, k.Root)
 }
 
+func TestParse(t *testing.T) {
+	k, err := Parse("Ab minor")
+	assert.Nil(t, err)
+	assert.Equal(t, Of("Ab minor"), k)
+
+	k, err = Parse("C")
+	assert.Nil(t, err)
+	assert.Equal(t, Of("C"), k)
+
+	for _, name := range []string{"C major", "C Major", "CM", "Cmaj", "Cm", "C min", "C minor ", "D Dorian", "A melodic min"} {
+		_, err = Parse(name)
+		assert.Nil(t, err, name)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	assertParseError(t, "H minor", note.RootPart, 0)
+	assertParseError(t, "", note.RootPart, 0)
+	assertParseError(t, "Eb♭ major", note.AccidentalPart, 2)
+	assertParseError(t, "C joe", note.ModePart, 2)
+	assertParseError(t, "C majestic", note.ModePart, 2)
+	assertParseError(t, "C minority", note.ModePart, 2)
+	assertParseError(t, "D dorianish", note.ModePart, 2)
+}
+
 //
 // Private
 //
 
+func assertParseError(t *testing.T, name string, expectPart note.NamePart, expectOffset int) {
+	k, err := Parse(name)
+	assert.Equal(t, &note.ParseError{Name: name, Part: expectPart, Offset: expectOffset}, err, name)
+	assert.Equal(t, Key{}, k)
+}
+
 type testKey struct {
 	Root string
 	Mode string
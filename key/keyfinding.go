@@ -3,20 +3,11 @@ package key
 
 import (
 	"math"
+	"sort"
 
 	"github.com/go-music-theory/music-theory/note"
 )
 
-// majorProfile contains the Krumhansl-Kessler key profile weights for major keys.
-// These empirically derived weights represent the stability of each pitch class in a major key context.
-// Index represents semitones from the tonic: [C, C#, D, D#, E, F, F#, G, G#, A, A#, B]
-var majorProfile = []float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
-
-// minorProfile contains the Krumhansl-Kessler key profile weights for minor keys.
-// These empirically derived weights represent the stability of each pitch class in a minor key context.
-// Index represents semitones from the tonic: [C, C#, D, D#, E, F, F#, G, G#, A, A#, B]
-var minorProfile = []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
-
 // FindKey implements the Krumhansl-Schmuckler key-finding algorithm.
 // It takes a slice of note.Class values and returns the most likely key.
 // The algorithm:
@@ -24,47 +15,61 @@ var minorProfile = []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.9
 // 2. Correlates this distribution with all 24 major and minor key profiles
 // 3. Returns the key with the highest correlation coefficient
 func FindKey(notes []note.Class) Key {
-	if len(notes) == 0 {
+	return FindKeyWith(notes, KrumhanslKessler)
+}
+
+// FindKeyWith a key profile, the most likely key of the notes, e.g. FindKeyWith(notes, key.Temperley)
+func FindKeyWith(notes []note.Class, profile Profile) Key {
+	ranked := RankKeys(notes, profile)
+	if len(ranked) == 0 {
 		return Key{}
 	}
+	return ranked[0].Key
+}
+
+// Correlation of a Key with the pitch class distribution of some notes, from -1 to 1
+type Correlation struct {
+	Key         Key
+	Coefficient float64
+}
 
-	// Calculate pitch class distribution
-	distribution := calculatePitchClassDistribution(notes)
+// RankKeys of the notes, all 24 major and minor keys ranked by the correlation coefficient of the key profile with the pitch class distribution of the notes, highest first.
+// Keys of equal correlation are ranked major before minor, and by root from C to B.
+func RankKeys(notes []note.Class, profile Profile) []Correlation {
+	if len(notes) == 0 {
+		return nil
+	}
+	return rankKeysOf(calculatePitchClassDistribution(notes), profile)
+}
 
-	// Find the best matching key
-	var bestKey Key
-	var bestCorrelation = -2.0 // Correlation coefficient ranges from -1 to 1
+//
+// Private
+//
 
-	// Check all 12 major keys
+// rankKeysOf a pitch class distribution, by the correlation coefficient of each key of the profile with it, highest first
+func rankKeysOf(distribution []float64, profile Profile) (ranked []Correlation) {
 	allNotes := []note.Class{note.C, note.Cs, note.D, note.Ds, note.E, note.F, note.Fs, note.G, note.Gs, note.A, note.As, note.B}
-	for _, root := range allNotes {
-		rotatedDistribution := rotateDistribution(distribution, classToSemitone(root))
-		correlation := correlate(rotatedDistribution, majorProfile)
-		if correlation > bestCorrelation {
-			bestCorrelation = correlation
-			bestKey = Key{
-				Root:      root,
-				Mode:      Major,
-				AdjSymbol: sharpOrFlat(root),
-			}
+	for _, mode := range []Mode{Major, Minor} {
+		weights := profile.Weights(mode)
+		if len(weights) != 12 {
+			continue
 		}
-	}
-
-	// Check all 12 minor keys
-	for _, root := range allNotes {
-		rotatedDistribution := rotateDistribution(distribution, classToSemitone(root))
-		correlation := correlate(rotatedDistribution, minorProfile)
-		if correlation > bestCorrelation {
-			bestCorrelation = correlation
-			bestKey = Key{
-				Root:      root,
-				Mode:      Minor,
-				AdjSymbol: sharpOrFlat(root),
-			}
+		for _, root := range allNotes {
+			rotatedDistribution := rotateDistribution(distribution, classToSemitone(root))
+			ranked = append(ranked, Correlation{
+				Key: Key{
+					Root:      root,
+					Mode:      mode,
+					AdjSymbol: sharpOrFlat(root),
+				},
+				Coefficient: correlate(rotatedDistribution, weights),
+			})
 		}
 	}
-
-	return bestKey
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Coefficient > ranked[j].Coefficient
+	})
+	return
 }
 
 // calculatePitchClassDistribution creates a histogram of pitch classes.
@@ -87,6 +87,61 @@ func TestFindKey_EMinorScale(t *testing.T) {
 	assert.Equal(t, Minor, result.Mode, "Expected mode to be Minor")
 }
 
+// TestRankKeys tests that all 24 keys are ranked by their correlation coefficient
+func TestRankKeys(t *testing.T) {
+	notes := []note.Class{note.C, note.D, note.E, note.F, note.G, note.A, note.B}
+	ranked := RankKeys(notes, KrumhanslKessler)
+
+	assert.Equal(t, 24, len(ranked), "Expected all 24 major and minor keys")
+	assert.Equal(t, FindKey(notes), ranked[0].Key, "Expected the best key to be the one found")
+	for i := 1; i < len(ranked); i++ {
+		assert.True(t, ranked[i-1].Coefficient >= ranked[i].Coefficient, "Expected keys ranked highest first")
+	}
+	assert.True(t, ranked[0].Coefficient <= 1 && ranked[23].Coefficient >= -1, "Expected coefficients from -1 to 1")
+
+	// the relative minor is a close second
+	assert.Equal(t, note.A, ranked[1].Key.Root, "Expected the runner-up root to be A")
+	assert.Equal(t, Minor, ranked[1].Key.Mode, "Expected the runner-up mode to be Minor")
+
+	keys := make(map[Key]bool)
+	for _, c := range ranked {
+		keys[c.Key] = true
+	}
+	assert.Equal(t, 24, len(keys), "Expected each key to be ranked once")
+}
+
+// TestRankKeys_EmptyInput tests that empty input ranks no keys
+func TestRankKeys_EmptyInput(t *testing.T) {
+	assert.Nil(t, RankKeys([]note.Class{}, Temperley))
+}
+
+// TestFindKeyWith_Profiles tests finding keys with each built-in profile
+func TestFindKeyWith_Profiles(t *testing.T) {
+	for _, profile := range []KeyProfile{KrumhanslKessler, Temperley, AardenEssen, BellmanBudge, AlbrechtShanahan} {
+		result := FindKeyWith([]note.Class{note.G, note.A, note.B, note.C, note.D, note.E, note.Fs, note.G, note.D, note.B, note.G}, profile)
+		assert.Equal(t, note.G, result.Root, profile.Name)
+		assert.Equal(t, Major, result.Mode, profile.Name)
+
+		result = FindKeyWith([]note.Class{note.D, note.D, note.D, note.E, note.F, note.G, note.A, note.As, note.Cs, note.D, note.A, note.F}, profile)
+		assert.Equal(t, note.D, result.Root, profile.Name)
+		assert.Equal(t, Minor, result.Mode, profile.Name)
+	}
+}
+
+// TestFindKeyWith_CustomProfile tests finding a key with a custom profile
+func TestFindKeyWith_CustomProfile(t *testing.T) {
+	// a profile of only the tonic triad
+	triads := KeyProfile{Name: "Triads",
+		Major: []float64{1, 0, 0, 0, 1, 0, 0, 1, 0, 0, 0, 0},
+		Minor: []float64{1, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 0}}
+	result := FindKeyWith([]note.Class{note.E, note.G, note.B, note.E, note.D}, triads)
+	assert.Equal(t, note.E, result.Root, "Expected root to be E")
+	assert.Equal(t, Minor, result.Mode, "Expected mode to be Minor")
+
+	// a profile without weights finds no key
+	assert.Equal(t, Key{}, FindKeyWith([]note.Class{note.C}, KeyProfile{}))
+}
+
 // TestCalculatePitchClassDistribution tests the pitch class distribution calculator
 func TestCalculatePitchClassDistribution(t *testing.T) {
 	notes := []note.Class{note.C, note.C, note.E, note.G}
@@ -1,4 +1,4 @@
-// Key has a Mode, e.g. Major or Minor
+// Key has a Mode, e.g. Major or Minor, or one of the church modes, e.g. Dorian, or the harmonic or melodic minor
 package key
 
 import (
@@ -12,9 +12,22 @@ const (
 	Nil Mode = iota
 	Major
 	Minor
+	Dorian
+	Phrygian
+	Lydian
+	Mixolydian
+	Locrian
+	HarmonicMinor
+	MelodicMinor
 )
 
-// String of the Mode, e.g. "Major" or "Minor"
+// Ionian and Aeolian are the church modes of the Major and (natural) Minor keys
+const (
+	Ionian  = Major
+	Aeolian = Minor
+)
+
+// String of the Mode, e.g. "Major", "Minor" or "Dorian"
 func (of Mode) String() string {
 	switch of {
 	case Nil:
@@ -23,6 +36,20 @@ func (of Mode) String() string {
 		return "Major"
 	case Minor:
 		return "Minor"
+	case Dorian:
+		return "Dorian"
+	case Phrygian:
+		return "Phrygian"
+	case Lydian:
+		return "Lydian"
+	case Mixolydian:
+		return "Mixolydian"
+	case Locrian:
+		return "Locrian"
+	case HarmonicMinor:
+		return "Harmonic Minor"
+	case MelodicMinor:
+		return "Melodic Minor"
 	}
 	return ""
 }
@@ -32,16 +59,61 @@ func (of Mode) String() string {
 //
 
 var (
-	rgxMajor, _ = regexp.Compile("^(M|maj|major)")
+	rgxMajor, _ = regexp.Compile("^(M|maj|Maj|major|Major)")
 	rgxMinor, _ = regexp.Compile("^(m\\b|min|minor|Minor)")
 )
 
+// Expressions of the whole of a mode name, with nothing but whitespace after it, to strictly parse a key
+var (
+	rgxStrictMajor = regexp.MustCompile(rgxMajor.String() + "\\s*$")
+	rgxStrictMinor = regexp.MustCompile(rgxMinor.String() + "\\s*$")
+)
+
+// Expressions of the modes other than major and minor, in the order they are to be matched
+var rgxModes = []modeExp{
+	modeExpOf("^(?i)harmonic[. -]*min(or)?", HarmonicMinor),
+	modeExpOf("^(?i)melodic[. -]*min(or)?", MelodicMinor),
+	modeExpOf("^(?i)ionian", Ionian),
+	modeExpOf("^(?i)dorian", Dorian),
+	modeExpOf("^(?i)phrygian", Phrygian),
+	modeExpOf("^(?i)lydian", Lydian),
+	modeExpOf("^(?i)mixolydian", Mixolydian),
+	modeExpOf("^(?i)aeolian", Aeolian),
+	modeExpOf("^(?i)locrian", Locrian),
+}
+
+// modeExp matches the beginning of a name to a mode, or strictly, the whole of the name
+type modeExp struct {
+	rgx    *regexp.Regexp
+	strict *regexp.Regexp
+	mode   Mode
+}
+
+func modeExpOf(pattern string, mode Mode) modeExp {
+	return modeExp{regexp.MustCompile(pattern), regexp.MustCompile(pattern + "\\s*$"), mode}
+}
+
 func (k *Key) parseMode(name string) {
 	// parse the chord Mode
 	k.Mode = modeOf(name)
 }
 
+// isModeName is true if the whole name is a recognized mode, rather than falling back to the default
+func isModeName(name string) bool {
+	for _, m := range rgxModes {
+		if m.strict.MatchString(name) {
+			return true
+		}
+	}
+	return rgxStrictMinor.MatchString(name) || rgxStrictMajor.MatchString(name)
+}
+
 func modeOf(name string) Mode {
+	for _, m := range rgxModes {
+		if m.rgx.MatchString(name) {
+			return m.mode
+		}
+	}
 	switch {
 	case rgxMinor.MatchString(name):
 		return Minor
@@ -51,3 +123,9 @@ func modeOf(name string) Mode {
 		return Major
 	}
 }
+
+// isMinor is true for a mode with a minor third above its tonic, e.g. Dorian
+func (of Mode) isMinor() bool {
+	semitones, ok := modeSemitones[of]
+	return ok && semitones[2] == 3
+}
@@ -2,15 +2,24 @@
 package key
 
 import (
-	"gopkg.in/stretchr/testify.v1/assert"
 	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestModeString(t *testing.T) {
 	assert.Equal(t, "Major", Major.String())
 	assert.Equal(t, "Minor", Minor.String())
 	assert.Equal(t, "Nil", Nil.String())
-	m := Mode(5)
+	assert.Equal(t, "Dorian", Dorian.String())
+	assert.Equal(t, "Locrian", Locrian.String())
+	assert.Equal(t, "Harmonic Minor", HarmonicMinor.String())
+	assert.Equal(t, "Melodic Minor", MelodicMinor.String())
+	assert.Equal(t, "Major", Ionian.String())
+	assert.Equal(t, "Minor", Aeolian.String())
+	m := Mode(99)
 	assert.Equal(t, "", m.String())
 }
 
@@ -25,3 +34,30 @@ func TestModeOf(t *testing.T) {
 
 	assert.Equal(t, Major, modeOf("joe"))
 }
+
+func TestModeOf_Modal(t *testing.T) {
+	assert.Equal(t, Ionian, modeOf("ionian"))
+	assert.Equal(t, Dorian, modeOf("dorian"))
+	assert.Equal(t, Dorian, modeOf("Dorian"))
+	assert.Equal(t, Phrygian, modeOf("phrygian"))
+	assert.Equal(t, Lydian, modeOf("lydian"))
+	assert.Equal(t, Mixolydian, modeOf("mixolydian"))
+	assert.Equal(t, Mixolydian, modeOf("Mixolydian"))
+	assert.Equal(t, Aeolian, modeOf("aeolian"))
+	assert.Equal(t, Locrian, modeOf("locrian"))
+	assert.Equal(t, HarmonicMinor, modeOf("harmonic minor"))
+	assert.Equal(t, HarmonicMinor, modeOf("Harmonic Minor"))
+	assert.Equal(t, MelodicMinor, modeOf("melodic minor"))
+	assert.Equal(t, MelodicMinor, modeOf("melodic min"))
+}
+
+func TestKeyOf_Modal(t *testing.T) {
+	assert.Equal(t, Key{Root: note.D, AdjSymbol: note.Sharp, Mode: Dorian}, Of("D dorian"))
+	assert.Equal(t, Key{Root: note.G, AdjSymbol: note.Sharp, Mode: Mixolydian}, Of("G Mixolydian"))
+	assert.Equal(t, Key{Root: note.A, AdjSymbol: note.Flat, Mode: HarmonicMinor}, Of("A harmonic minor"))
+	k, err := Parse("Bb lydian")
+	assert.Nil(t, err)
+	assert.Equal(t, Key{Root: note.As, AdjSymbol: note.Flat, Mode: Lydian}, k)
+	_, err = Parse("C hypodorian")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,232 @@
+// Roman numerals name chords by the degree of the key on which they are built, e.g. in Eb major, ii7 is Fm7, V7/V is F7, bVII is Db and I6/4 is Eb/Bb.
+package key
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Chord of a Roman numeral in the key, e.g. Of("Eb major").Chord("V7/V") is F7, returning a *note.ParseError if the numeral or figure is not understood.
+// Upper case numerals are major and lower case are minor, qualified by ° (diminished), ø (half-diminished), + (augmented) or maj (major seventh), and figured for sevenths and inversions, e.g. "V6/5" or "I6/4".
+// A seventh is the diatonic seventh of the key (or of the key tonicized by a secondary numeral, e.g. "V7/V"), else a minor seventh.
+func (this Key) Chord(numeral string) (chord.Chord, error) {
+	n, err := parseNumeral(numeral)
+	if err != nil {
+		return chord.Chord{}, err
+	}
+	return this.chordOf(n), nil
+}
+
+// Chords of a sequence of Roman numerals in the key, separated by spaces, dashes, commas or bar lines, e.g. "ii7 - V7/V - bVII - I6/4"
+func (this Key) Chords(numerals string) (chords []chord.Chord, err error) {
+	for _, field := range numeralFields(numerals) {
+		c, err := this.Chord(field.text)
+		if err != nil {
+			return nil, err.(*note.ParseError).Within(numerals, field.offset)
+		}
+		chords = append(chords, c)
+	}
+	return
+}
+
+//
+// Private
+//
+
+// numeral parsed from a Roman numeral, e.g. "bVII" or "V6/5/V"
+type numeral struct {
+	neapolitan bool
+	accidental int  // -1 for flat, +1 for sharp
+	degree     int  // 1 (I) to 7 (VII)
+	upper      bool // upper case numerals are major
+	quality    string
+	seventh    bool
+	inversion  int // 0 for root position, 1 for the third in the bass, 2 for the fifth, 3 for the seventh
+	secondary  *numeral
+}
+
+var (
+	rgxNumeral, _       = regexp.Compile(`^(N|([b♭#♯]?)(VII|VI|IV|V|III|II|I|vii|vi|iv|v|iii|ii|i)(°|o|ø|\+|maj|M)?)`)
+	rgxNumeralFigure, _ = regexp.Compile(`^(6/4|6/5|4/3|4/2|64|65|43|42|7|6|2)`)
+)
+
+// Roman numerals of the degrees, 1 to 7
+var romanDegrees = map[string]int{"I": 1, "II": 2, "III": 3, "IV": 4, "V": 5, "VI": 6, "VII": 7}
+
+// Figures of inversions of triads and sevenths, and whether each is a seventh chord
+var numeralFigures = map[string]struct {
+	inversion int
+	seventh   bool
+}{
+	"":    {0, false},
+	"6":   {1, false},
+	"6/4": {2, false},
+	"64":  {2, false},
+	"7":   {0, true},
+	"6/5": {1, true},
+	"65":  {1, true},
+	"4/3": {2, true},
+	"43":  {2, true},
+	"4/2": {3, true},
+	"42":  {3, true},
+	"2":   {3, true},
+}
+
+func parseNumeral(text string) (*numeral, error) {
+	m := rgxNumeral.FindStringSubmatch(text)
+	if m == nil {
+		return nil, &note.ParseError{Name: text, Part: note.NumeralPart, Offset: 0}
+	}
+	n := &numeral{}
+	if m[1] == "N" {
+		n.neapolitan = true
+		n.accidental = -1
+		n.degree = 2
+		n.upper = true
+	} else {
+		switch m[2] {
+		case "b", "♭":
+			n.accidental = -1
+		case "#", "♯":
+			n.accidental = 1
+		}
+		n.degree = romanDegrees[strings.ToUpper(m[3])]
+		n.upper = unicode.IsUpper(rune(m[3][0]))
+		n.quality = m[4]
+	}
+
+	rest := text[len(m[0]):]
+	figure := rgxNumeralFigure.FindString(rest)
+	f := numeralFigures[figure]
+	n.inversion = f.inversion
+	n.seventh = f.seventh || n.quality == "ø" || n.quality == "maj" || n.quality == "M"
+	rest = rest[len(figure):]
+
+	if len(rest) == 0 {
+		return n, nil
+	}
+	offset := len(text) - len(rest)
+	if rest[0] != '/' || n.neapolitan {
+		return nil, &note.ParseError{Name: text, Part: note.FigurePart, Offset: offset}
+	}
+	secondary, err := parseNumeral(rest[1:])
+	if err != nil {
+		return nil, err.(*note.ParseError).Within(text, offset+1)
+	}
+	n.secondary = secondary
+	return n, nil
+}
+
+// chordOf the numeral in the key
+func (this Key) chordOf(n *numeral) chord.Chord {
+	k := this
+	if n.secondary != nil {
+		// the tonicized key, spelled by its own signature, e.g. G major (with F#) for V/V in C minor
+		target := this.chordOf(n.secondary)
+		k = Key{Root: target.Root, AdjSymbol: target.AdjSymbol, Mode: Minor}
+		if n.secondary.upper {
+			k.Mode = Major
+		}
+		if k.Root.IsChromatic() {
+			k = FromSignature(k.Signature(), k.Mode)
+		}
+	}
+
+	scale := k.diatonicSets()[0]
+	semitones := scale[n.degree-1] + n.accidental
+	if k.Mode == Minor && n.degree == 7 && n.accidental == 0 && (n.quality == "°" || n.quality == "o" || n.quality == "ø") {
+		semitones = 11 // the leading tone, raised from the natural minor
+	}
+	root, _ := k.Root.Step(semitones)
+
+	third, fifth := 4, 7
+	switch {
+	case n.quality == "°" || n.quality == "o" || n.quality == "ø":
+		third, fifth = 3, 6
+	case n.quality == "+":
+		fifth = 8
+	case !n.upper:
+		third = 3
+	}
+
+	c := chord.Chord{
+		Root:      root,
+		AdjSymbol: k.adjSymbolOf(n.degree, semitones),
+		Tones:     map[chord.Interval]note.Class{chord.I1: root},
+	}
+	c.Tones[chord.I3], _ = root.Step(third)
+	c.Tones[chord.I5], _ = root.Step(fifth)
+	if n.seventh {
+		c.Tones[chord.I7], _ = root.Step(k.seventhAbove(n, semitones))
+	}
+
+	if n.inversion > 0 {
+		c.Bass = c.Tones[[]chord.Interval{chord.I3, chord.I5, chord.I7}[n.inversion-1]]
+	}
+	return c
+}
+
+// adjSymbolOf the root of a numeral on a degree of the key, some semitones above the tonic, by the accidental of its letter name, e.g. Sharp for C#, the raised leading tone of D minor, or Flat for Bb, the bVII of C major
+func (this Key) adjSymbolOf(degree int, semitones int) note.AdjSymbol {
+	tones := this.SpelledScale()
+	if len(tones) < degree {
+		return this.AdjSymbol
+	}
+	tone := tones[degree-1]
+	raised := ((semitones-semitonesAbove(this.Root, tone.Class()))%12 + 12) % 12
+	if raised > 6 {
+		raised -= 12
+	}
+	switch accidental := tone.Accidental + raised; {
+	case accidental > 0:
+		return note.Sharp
+	case accidental < 0:
+		return note.Flat
+	}
+	return this.AdjSymbol
+}
+
+// seventhAbove the root of the numeral, some semitones above the tonic, in semitones
+func (this Key) seventhAbove(n *numeral, root int) int {
+	switch n.quality {
+	case "ø":
+		return 10
+	case "°", "o":
+		return 9
+	case "maj", "M":
+		return 11
+	}
+	scale := this.diatonicSets()[0]
+	if n.accidental == 0 && scale[n.degree-1] == root {
+		return ((scale[(n.degree+5)%7]-root)%12 + 12) % 12
+	}
+	return 10
+}
+
+// numeralField of a sequence, with its byte offset
+type numeralField struct {
+	text   string
+	offset int
+}
+
+// numeralFields of a sequence separated by spaces, dashes, commas or bar lines
+func numeralFields(text string) (fields []numeralField) {
+	start := -1
+	for i, r := range text {
+		separator := unicode.IsSpace(r) || r == '-' || r == '–' || r == ',' || r == '|'
+		if separator && start >= 0 {
+			fields = append(fields, numeralField{text: text[start:i], offset: start})
+			start = -1
+		} else if !separator && start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, numeralField{text: text[start:], offset: start})
+	}
+	return
+}
@@ -0,0 +1,125 @@
+// Roman numerals name chords by the degree of the key on which they are built, e.g. in Eb major, ii7 is Fm7, V7/V is F7, bVII is Db and I6/4 is Eb/Bb.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChord(t *testing.T) {
+	assertNumeralChord(t, "Eb major", "ii7", "Fm7")
+	assertNumeralChord(t, "Eb major", "V7/V", "F7")
+	assertNumeralChord(t, "Eb major", "bVII", "Db")
+	assertNumeralChord(t, "Eb major", "I6/4", "Eb/Bb")
+	assertNumeralChord(t, "C major", "I", "C")
+	assertNumeralChord(t, "C major", "Imaj7", "Cmaj7")
+	assertNumeralChord(t, "C major", "IV7", "Fmaj7")
+	assertNumeralChord(t, "C major", "vii°", "Bdim")
+	assertNumeralChord(t, "C major", "viiø7", "Bm7b5")
+	assertNumeralChord(t, "C major", "V6/5", "G7/B")
+	assertNumeralChord(t, "C major", "V4/3", "G7/D")
+	assertNumeralChord(t, "C major", "V4/2", "G7/F")
+	assertNumeralChord(t, "C major", "V42", "G7/F")
+	assertNumeralChord(t, "C major", "ii6", "Dm/F")
+	assertNumeralChord(t, "C major", "V/vi", "E")
+	assertNumeralChord(t, "C major", "vii°7/V", "F#dim7")
+	assertNumeralChord(t, "C major", "V6/5/V", "D7/F#")
+	assertNumeralChord(t, "C major", "N6", "Db/F")
+	assertNumeralChord(t, "C major", "bVI", "Ab")
+	assertNumeralChord(t, "C major", "iv", "Fm")
+	assertNumeralChord(t, "C major", "III+", "Eaug")
+	assertNumeralChord(t, "A minor", "i", "Am")
+	assertNumeralChord(t, "A minor", "V7", "E7")
+	assertNumeralChord(t, "A minor", "VII", "G")
+	assertNumeralChord(t, "A minor", "vii°7", "G#dim7")
+	assertNumeralChord(t, "A minor", "III", "C")
+	assertNumeralChord(t, "A minor", "ii°", "Bdim")
+}
+
+func TestChord_Spelling(t *testing.T) {
+	// the root of a secondary is spelled in the key it tonicizes, and a raised leading tone with a sharp
+	for keyName, expect := range map[string]map[string]string{
+		"C minor":  {"vii°7/V": "F#", "V7/V": "D", "bII": "Db"},
+		"F major":  {"vii°7/vi": "C#", "V/ii": "D", "IV": "Bb"},
+		"D minor":  {"vii°": "C#", "VI": "Bb"},
+		"Eb major": {"V/iii": "D", "vii°/V": "A"},
+		"C major":  {"bVII": "Bb", "#IV": "F#"},
+	} {
+		for numeral, root := range expect {
+			c, err := Of(keyName).Chord(numeral)
+			assert.Nil(t, err, numeral)
+			assert.Equal(t, root, c.Root.String(c.AdjSymbol), keyName+": "+numeral)
+		}
+	}
+}
+
+func TestChord_Invalid(t *testing.T) {
+	assertNumeralParseError(t, "X", note.NumeralPart, 0)
+	assertNumeralParseError(t, "Vi", note.FigurePart, 1)
+	assertNumeralParseError(t, "V9", note.FigurePart, 1)
+	assertNumeralParseError(t, "V7/X", note.NumeralPart, 3)
+	assertNumeralParseError(t, "N/V", note.FigurePart, 1)
+}
+
+func TestChords(t *testing.T) {
+	chords, err := Of("Eb major").Chords("ii7 - V7/V - bVII - I6/4")
+	assert.Nil(t, err)
+	if assert.Equal(t, 4, len(chords)) {
+		assertSameChord(t, "Fm7", chords[0])
+		assertSameChord(t, "F7", chords[1])
+		assertSameChord(t, "Db", chords[2])
+		assertSameChord(t, "Eb/Bb", chords[3])
+	}
+
+	chords, err = Of("G").Chords("| I | vi, IV | V7 |")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(chords))
+
+	_, err = Of("C").Chords("I - IV - Q - V")
+	assert.Equal(t, &note.ParseError{Name: "I - IV - Q - V", Part: note.NumeralPart, Offset: 9}, err)
+}
+
+func TestChord_RoundTrip(t *testing.T) {
+	for keyName, numerals := range map[string][]string{
+		"C major": {"I", "ii", "iii", "IV", "V", "vi", "vii°", "V7", "ii7", "Imaj7", "viiø7", "I6", "I6/4", "V6/5", "V4/3", "V4/2", "V/V", "V7/ii", "vii°/V", "vii°7/V", "bVI", "bVII", "bIII", "iv", "N6"},
+		"A minor": {"i", "ii°", "III", "iv", "V", "VI", "VII", "#VII", "V7", "vii°7", "N6"},
+		"C minor": {"V/V", "V7/V", "vii°7/V", "V7/iv", "vii°/iv"},
+		"F major": {"vii°7/vi", "V/ii", "V7/IV"},
+	} {
+		k := Of(keyName)
+		for _, numeral := range numerals {
+			c, err := k.Chord(numeral)
+			assert.Nil(t, err, numeral)
+			assert.Equal(t, numeral, k.Analyze(c).Numeral, keyName+": "+numeral)
+		}
+	}
+}
+
+//
+// Private
+//
+
+func assertNumeralChord(t *testing.T, keyName string, numeral string, expectChord string) {
+	c, err := Of(keyName).Chord(numeral)
+	assert.Nil(t, err, numeral)
+	assertSameChord(t, expectChord, c)
+}
+
+func assertSameChord(t *testing.T, expectName string, actual chord.Chord) {
+	expect := chord.Of(expectName)
+	assert.Equal(t, expect.Root, actual.Root, expectName)
+	assert.Equal(t, expect.Bass, actual.Bass, expectName)
+	assert.Equal(t, len(expect.Tones), len(actual.Tones), expectName)
+	for interval, class := range expect.Tones {
+		assert.Equal(t, class, actual.Tones[interval], expectName)
+	}
+}
+
+func assertNumeralParseError(t *testing.T, numeral string, expectPart note.NamePart, expectOffset int) {
+	_, err := Of("C").Chord(numeral)
+	assert.Equal(t, &note.ParseError{Name: numeral, Part: expectPart, Offset: expectOffset}, err, numeral)
+}
@@ -0,0 +1,50 @@
+// A key profile weighs how strongly each pitch class is heard in the key of each mode, by the semitones above its tonic, from the ratings of listeners or the counts of notes in a body of music. Keys are found by correlating the pitch classes of a piece with a profile.
+//
+// https://en.wikipedia.org/wiki/Key_finding
+package key
+
+// Profile of the weights of the pitch classes in a key, from which a key is found
+type Profile interface {
+	Weights(mode Mode) []float64 // Weights of the twelve semitones above the tonic of a key of the mode, or nil if the profile has no such mode
+}
+
+// KeyProfile of the weights of the twelve semitones above the tonic of a major and a minor key, e.g. a custom profile
+type KeyProfile struct {
+	Name  string
+	Major []float64
+	Minor []float64
+}
+
+var (
+	// KrumhanslKessler profile of the ratings by listeners of how well each tone fits the key of a preceding cadence
+	KrumhanslKessler = KeyProfile{Name: "Krumhansl-Kessler",
+		Major: []float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88},
+		Minor: []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}}
+	// Temperley profile of the proportion of segments in which each tone occurs, in the excerpts of the Kostka-Payne harmony textbook
+	Temperley = KeyProfile{Name: "Temperley",
+		Major: []float64{0.748, 0.060, 0.488, 0.082, 0.670, 0.460, 0.096, 0.715, 0.104, 0.366, 0.057, 0.400},
+		Minor: []float64{0.712, 0.084, 0.474, 0.618, 0.049, 0.460, 0.105, 0.747, 0.404, 0.067, 0.133, 0.330}}
+	// AardenEssen profile of the durations of each tone, in the folk songs of the Essen collection
+	AardenEssen = KeyProfile{Name: "Aarden-Essen",
+		Major: []float64{17.7661, 0.145624, 14.9265, 0.160186, 19.8049, 11.3587, 0.291248, 22.062, 0.145624, 8.15494, 0.232998, 4.95122},
+		Minor: []float64{18.2648, 0.737619, 14.0499, 16.8599, 0.702494, 14.4362, 0.702494, 18.6161, 4.56621, 1.93186, 7.37619, 1.75623}}
+	// BellmanBudge profile of the occurrences of each tone, in the chords of classical and romantic music
+	BellmanBudge = KeyProfile{Name: "Bellman-Budge",
+		Major: []float64{16.80, 0.86, 12.95, 1.41, 13.49, 11.93, 1.25, 20.28, 1.80, 8.04, 0.62, 10.57},
+		Minor: []float64{18.16, 0.69, 12.99, 13.34, 1.07, 11.15, 1.38, 21.07, 7.49, 1.53, 0.92, 10.21}}
+	// AlbrechtShanahan profile of the durations of each tone, in the first and last eight measures of pieces of the common practice period
+	AlbrechtShanahan = KeyProfile{Name: "Albrecht-Shanahan",
+		Major: []float64{0.238, 0.006, 0.111, 0.006, 0.137, 0.094, 0.016, 0.214, 0.009, 0.080, 0.008, 0.081},
+		Minor: []float64{0.220, 0.006, 0.104, 0.123, 0.019, 0.103, 0.012, 0.214, 0.062, 0.022, 0.061, 0.052}}
+)
+
+// Weights of the twelve semitones above the tonic of a Major or Minor key, or nil for a key of another mode
+func (this KeyProfile) Weights(mode Mode) []float64 {
+	switch mode {
+	case Major:
+		return this.Major
+	case Minor:
+		return this.Minor
+	}
+	return nil
+}
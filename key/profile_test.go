@@ -0,0 +1,25 @@
+// A key profile weighs how strongly each pitch class is heard in the key of each mode, by the semitones above its tonic, from the ratings of listeners or the counts of notes in a body of music. Keys are found by correlating the pitch classes of a piece with a profile.
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestKeyProfile_Weights(t *testing.T) {
+	for _, profile := range []KeyProfile{KrumhanslKessler, Temperley, AardenEssen, BellmanBudge, AlbrechtShanahan} {
+		for _, mode := range []Mode{Major, Minor} {
+			weights := profile.Weights(mode)
+			assert.Equal(t, 12, len(weights), profile.Name)
+			// the tonic and dominant weigh more than the tritone
+			assert.True(t, weights[0] > weights[6], profile.Name)
+			assert.True(t, weights[7] > weights[6], profile.Name)
+		}
+		// the major third of a major key, and the minor third of a minor key
+		assert.True(t, profile.Weights(Major)[4] > profile.Weights(Major)[3], profile.Name)
+		assert.True(t, profile.Weights(Minor)[3] > profile.Weights(Minor)[4], profile.Name)
+		assert.Nil(t, profile.Weights(Dorian), profile.Name)
+	}
+	assert.Equal(t, 6.35, KrumhanslKessler.Weights(Major)[0])
+}
@@ -1,4 +1,5 @@
 // The relative minor of a major key has the same key signature and starts down a minor third (or equivalently up a major sixth); for example, the relative minor of G major is E minor. Similarly the relative major of a minor key starts up a minor third (or down a major sixth); for example, the relative major of F minor is A♭ major.
+// Every mode has a relative key of each other mode, with the same key signature, e.g. D dorian is relative to C major, and a parallel key of each other mode, with the same tonic, e.g. C dorian is parallel to C major.
 package key
 
 import (
@@ -24,3 +25,18 @@ func (k Key) RelativeMajor() (rk Key) {
 	}
 	return
 }
+
+// Relative key of the given mode, with the same key signature, e.g. C major for D dorian, or G mixolydian for E minor
+func (k Key) Relative(mode Mode) Key {
+	if !k.Root.IsChromatic() {
+		return Key{}
+	}
+	return FromSignature(k.Signature(), mode)
+}
+
+// Parallel key of the given mode, with the same tonic, e.g. C minor or C dorian for C major
+func (k Key) Parallel(mode Mode) (pk Key) {
+	pk = k
+	pk.Mode = mode
+	return
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestRelativeMajor(t *testing.T) {
@@ -18,3 +20,19 @@ func TestRelativeMinor(t *testing.T) {
 	expectRk := Of("A minor")
 	assert.Equal(t, expectRk, k.RelativeMinor())
 }
+
+func TestRelative(t *testing.T) {
+	assert.Equal(t, Key{Root: note.C, AdjSymbol: note.Sharp, Mode: Major}, Of("D dorian").Relative(Major))
+	assert.Equal(t, Key{Root: note.D, AdjSymbol: note.Sharp, Mode: Dorian}, Of("C major").Relative(Dorian))
+	assert.Equal(t, Key{Root: note.D, AdjSymbol: note.Sharp, Mode: Mixolydian}, Of("E minor").Relative(Mixolydian))
+	assert.Equal(t, Key{Root: note.C, AdjSymbol: note.Flat, Mode: Dorian}, Of("Bb major").Relative(Dorian))
+	assert.Equal(t, Key{Root: note.A, AdjSymbol: note.Flat, Mode: Aeolian}, Of("F lydian").Relative(Aeolian))
+	assert.Equal(t, Key{Root: note.Fs, AdjSymbol: note.Sharp, Mode: Locrian}, Of("G major").Relative(Locrian))
+	assert.Equal(t, Key{}, Key{}.Relative(Major))
+}
+
+func TestParallel(t *testing.T) {
+	assert.Equal(t, Key{Root: note.C, AdjSymbol: note.Sharp, Mode: Dorian}, Of("C major").Parallel(Dorian))
+	assert.Equal(t, Of("A major").Root, Of("A minor").Parallel(Major).Root)
+	assert.Equal(t, Major, Of("A minor").Parallel(Major).Mode)
+}
@@ -0,0 +1,16 @@
+// The scale of a key is the seven tones of its mode from its tonic, e.g. D E F G A B C for D dorian
+package key
+
+import (
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Scale of the Key, e.g. the scale of D dorian, or the zero Scale if the Key has no root
+func (this Key) Scale() scale.Scale {
+	if !this.Root.IsChromatic() {
+		return scale.Scale{}
+	}
+	s := scale.Of(this.Root.String(this.AdjSymbol) + " " + this.Mode.String())
+	s.AdjSymbol = this.AdjSymbol
+	return s
+}
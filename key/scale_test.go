@@ -0,0 +1,42 @@
+// The scale of a key is the seven tones of its mode from its tonic, e.g. D E F G A B C for D dorian
+package key
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestKey_Scale(t *testing.T) {
+	s := Of("D dorian").Scale()
+	assert.Equal(t, note.D, s.Root)
+	assert.Equal(t, map[scale.Interval]note.Class{
+		scale.I1: note.D,
+		scale.I2: note.E,
+		scale.I3: note.F,
+		scale.I4: note.G,
+		scale.I5: note.A,
+		scale.I6: note.B,
+		scale.I7: note.C,
+	}, s.Tones)
+
+	s = Of("C harmonic minor").Scale()
+	assert.Equal(t, note.Ds, s.Tones[scale.I3])
+	assert.Equal(t, note.Gs, s.Tones[scale.I6])
+	assert.Equal(t, note.B, s.Tones[scale.I7])
+
+	s = Of("A melodic minor").Scale()
+	assert.Equal(t, note.Fs, s.Tones[scale.I6])
+	assert.Equal(t, note.Gs, s.Tones[scale.I7])
+	assert.Equal(t, note.F, s.Descend[scale.I6])
+	assert.Equal(t, note.G, s.Descend[scale.I7])
+
+	s = Of("Bb lydian").Scale()
+	assert.Equal(t, note.Flat, s.AdjSymbol)
+	assert.Equal(t, note.E, s.Tones[scale.I4])
+
+	assert.Equal(t, scale.Scale{}, Key{}.Scale())
+}
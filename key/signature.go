@@ -0,0 +1,177 @@
+// The key signature of a key is its number of sharps or flats, written in order along the circle of fifths, e.g. F# C# G# for A major or Bb Eb Ab for C minor.
+package key
+
+import (
+	"strconv"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Signature of a key, as its number of sharps (positive) or flats (negative), from -7 to 7, e.g. -3 for Eb major or C minor
+type Signature int
+
+// Sharps signature, e.g. Sharps(2) for D major or B minor
+func Sharps(n int) Signature {
+	return Signature(n)
+}
+
+// Flats signature, e.g. Flats(3) for Eb major or C minor
+func Flats(n int) Signature {
+	return Signature(-n)
+}
+
+// Signature of the Key, spelled with its AdjSymbol, e.g. Gb major has 6 flats and F# major has 6 sharps.
+// A key of more than seven sharps or flats has the signature of its enharmonic equivalent, e.g. G# major has the 4 flats of Ab major.
+func (this Key) Signature() Signature {
+	if !this.Root.IsChromatic() {
+		return 0
+	}
+	// fifths above C of the relative major
+	fifths := ((7*semitonesAbove(note.C, this.Root)-modeFifths[this.Mode])%12 + 12) % 12
+	if this.AdjSymbol == note.Flat && fifths > 0 {
+		fifths -= 12
+	}
+	if fifths > 7 {
+		fifths -= 12
+	} else if fifths < -7 {
+		fifths += 12
+	}
+	return Signature(fifths)
+}
+
+// FromSignature of sharps or flats, the key of the given mode, e.g. FromSignature(Flats(3), Minor) is C minor.
+// Returns the zero Key for a signature of more than seven sharps or flats.
+func FromSignature(s Signature, mode Mode) Key {
+	if s < -7 || s > 7 {
+		return Key{}
+	}
+	k := Key{Root: spelledOnFifths(int(s) + modeFifths[mode]).Class(), Mode: mode}
+	switch {
+	case s > 0:
+		k.AdjSymbol = note.Sharp
+	case s < 0:
+		k.AdjSymbol = note.Flat
+	case mode != Minor && mode != HarmonicMinor && mode != MelodicMinor:
+		k.AdjSymbol = note.Sharp
+	default:
+		k.AdjSymbol = note.Flat
+	}
+	return k
+}
+
+// Sharps in the Signature, or 0 if it has flats
+func (this Signature) Sharps() int {
+	if this > 0 {
+		return int(this)
+	}
+	return 0
+}
+
+// Flats in the Signature, or 0 if it has sharps
+func (this Signature) Flats() int {
+	if this < 0 {
+		return -int(this)
+	}
+	return 0
+}
+
+// Accidentals of the Signature in the order they are written, sharps F# C# G# D# A# E# B#, or flats Bb Eb Ab Db Gb Cb Fb
+func (this Signature) Accidentals() (accidentals []note.Spelled) {
+	for i := 0; i < this.Sharps(); i++ {
+		accidentals = append(accidentals, spelledOnFifths(6+i))
+	}
+	for i := 0; i < this.Flats(); i++ {
+		accidentals = append(accidentals, spelledOnFifths(-2-i))
+	}
+	return
+}
+
+// String of the Signature, e.g. "3 flats", "1 sharp" or "no sharps or flats"
+func (this Signature) String() string {
+	switch {
+	case this == 1:
+		return "1 sharp"
+	case this == -1:
+		return "1 flat"
+	case this > 0:
+		return strconv.Itoa(this.Sharps()) + " sharps"
+	case this < 0:
+		return strconv.Itoa(this.Flats()) + " flats"
+	}
+	return "no sharps or flats"
+}
+
+// SpelledScale of the Key, its seven tones from the tonic, each spelled by a letter name with the accidental of its Signature, e.g. F# G# A# B C# D# E# for F# major.
+// The harmonic and melodic minor raise their degrees above the signature of the minor key, e.g. G# in A harmonic minor.
+func (this Key) SpelledScale() (tones []note.Spelled) {
+	if !this.Root.IsChromatic() {
+		return nil
+	}
+	s := this.Signature()
+	tonic := spelledOnFifths(int(s) + modeFifths[this.Mode])
+	semitones, ok := modeSemitones[this.Mode]
+	if !ok {
+		semitones = modeSemitones[Major]
+	}
+	for i := 0; i < 7; i++ {
+		tone := s.spelled(tonic.Letter.Step(i))
+		tone.Accidental += semitones[i] - semitonesAbove(tonic.Class(), tone.Class())
+		tones = append(tones, tone)
+	}
+	return
+}
+
+// Enharmonic key of the same pitches spelled with the other accidentals, e.g. Gb major for F# major, or false if it would have more than seven sharps or flats, e.g. for E major
+func (this Key) Enharmonic() (Key, bool) {
+	if !this.Root.IsChromatic() {
+		return Key{}, false
+	}
+	s := this.Signature()
+	switch {
+	case s > 0 && s-12 >= -7:
+		return FromSignature(s-12, this.Mode), true
+	case s < 0 && s+12 <= 7:
+		return FromSignature(s+12, this.Mode), true
+	}
+	return Key{}, false
+}
+
+//
+// Private
+//
+
+// modeFifths of the tonic of each mode above the tonic of its relative major, on the line of fifths
+var modeFifths = map[Mode]int{
+	Major:         0,
+	Minor:         3,
+	Dorian:        2,
+	Phrygian:      4,
+	Lydian:        -1,
+	Mixolydian:    1,
+	Locrian:       5,
+	HarmonicMinor: 3,
+	MelodicMinor:  3,
+}
+
+// lettersOnFifths in order along the line of fifths, from F
+var lettersOnFifths = []note.Letter{note.FLetter, note.CLetter, note.GLetter, note.DLetter, note.ALetter, note.ELetter, note.BLetter}
+
+// spelledOnFifths is the pitch some fifths above (or below) C on the line of fifths, e.g. 2 is D, 6 is F# and -2 is Bb
+func spelledOnFifths(fifths int) note.Spelled {
+	i := fifths + 1
+	octaves := i / 7
+	if i < 0 && i%7 != 0 {
+		octaves--
+	}
+	return note.Spelled{Letter: lettersOnFifths[i-7*octaves], Accidental: octaves}
+}
+
+// spelled letter with the accidental of the Signature
+func (this Signature) spelled(letter note.Letter) note.Spelled {
+	for _, a := range this.Accidentals() {
+		if a.Letter == letter {
+			return a
+		}
+	}
+	return note.Spelled{Letter: letter}
+}
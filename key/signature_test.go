@@ -0,0 +1,165 @@
+// The key signature of a key is its number of sharps or flats, written in order along the circle of fifths, e.g. F# C# G# for A major or Bb Eb Ab for C minor.
+package key
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestKey_Signature(t *testing.T) {
+	for name, expect := range map[string]Signature{
+		"C":                0,
+		"G":                1,
+		"D":                2,
+		"A":                3,
+		"E":                4,
+		"B":                5,
+		"F# major":         6,
+		"C#":               7,
+		"F":                -1,
+		"Bb":               -2,
+		"Eb":               -3,
+		"Ab":               -4,
+		"Db":               -5,
+		"Gb":               -6,
+		"Cb":               -7,
+		"A minor":          0,
+		"E minor":          1,
+		"B minor":          2,
+		"F# minor":         3,
+		"C# minor":         4,
+		"G# minor":         5,
+		"D# minor":         6,
+		"A# minor":         7,
+		"D minor":          -1,
+		"G minor":          -2,
+		"C minor":          -3,
+		"F minor":          -4,
+		"Bb minor":         -5,
+		"Eb minor":         -6,
+		"Ab minor":         -7,
+		"G# major":         -4, // enharmonic Ab major
+		"Fb major":         4,  // enharmonic E major
+		"D dorian":         0,
+		"G dorian":         -1,
+		"A dorian":         1,
+		"E phrygian":       0,
+		"F lydian":         0,
+		"D mixolydian":     1,
+		"B locrian":        0,
+		"A harmonic minor": 0,
+		"C melodic minor":  -3,
+	} {
+		assert.Equal(t, expect, Of(name).Signature(), name)
+	}
+	assert.Equal(t, Signature(0), Key{}.Signature())
+}
+
+func TestFromSignature(t *testing.T) {
+	assert.Equal(t, Of("C minor"), FromSignature(Flats(3), Minor))
+	assert.Equal(t, Of("Eb major"), FromSignature(Flats(3), Major))
+	assert.Equal(t, Of("A minor"), FromSignature(0, Minor))
+	assert.Equal(t, Of("C major"), FromSignature(0, Major))
+	assert.Equal(t, Key{Root: note.B, AdjSymbol: note.Sharp, Mode: Minor}, FromSignature(Sharps(2), Minor))
+	assert.Equal(t, Key{Root: note.Fs, AdjSymbol: note.Sharp, Mode: Major}, FromSignature(Sharps(6), Major))
+	assert.Equal(t, Key{Root: note.B, AdjSymbol: note.Flat, Mode: Major}, FromSignature(Flats(7), Major))
+	assert.Equal(t, Key{}, FromSignature(Sharps(8), Major))
+	for s := Flats(7); s <= Sharps(7); s++ {
+		assert.Equal(t, s, FromSignature(s, Major).Signature())
+		assert.Equal(t, s, FromSignature(s, Minor).Signature())
+		assert.Equal(t, s, FromSignature(s, Dorian).Signature())
+		assert.Equal(t, s, FromSignature(s, Locrian).Signature())
+	}
+}
+
+func TestSignature_Accidentals(t *testing.T) {
+	assertAccidentals(t, "", 0)
+	assertAccidentals(t, "F#", Sharps(1))
+	assertAccidentals(t, "F# C# G# D# A# E# B#", Sharps(7))
+	assertAccidentals(t, "Bb", Flats(1))
+	assertAccidentals(t, "Bb Eb Ab", Flats(3))
+	assertAccidentals(t, "Bb Eb Ab Db Gb Cb Fb", Flats(7))
+}
+
+func TestSignature_SharpsFlats(t *testing.T) {
+	assert.Equal(t, 3, Sharps(3).Sharps())
+	assert.Equal(t, 0, Sharps(3).Flats())
+	assert.Equal(t, 3, Flats(3).Flats())
+	assert.Equal(t, 0, Flats(3).Sharps())
+}
+
+func TestSignature_String(t *testing.T) {
+	assert.Equal(t, "no sharps or flats", Signature(0).String())
+	assert.Equal(t, "1 sharp", Sharps(1).String())
+	assert.Equal(t, "4 sharps", Sharps(4).String())
+	assert.Equal(t, "1 flat", Flats(1).String())
+	assert.Equal(t, "3 flats", Flats(3).String())
+}
+
+func TestKey_SpelledScale(t *testing.T) {
+	assertSpelledScale(t, "C D E F G A B", "C")
+	assertSpelledScale(t, "F# G# A# B C# D# E#", "F# major")
+	assertSpelledScale(t, "Gb Ab Bb Cb Db Eb F", "Gb")
+	assertSpelledScale(t, "C# D# E# F# G# A# B#", "C#")
+	assertSpelledScale(t, "Cb Db Eb Fb Gb Ab Bb", "Cb")
+	assertSpelledScale(t, "C D Eb F G Ab Bb", "C minor")
+	assertSpelledScale(t, "D# E# F# G# A# B C#", "D# minor")
+	assertSpelledScale(t, "E F# G A B C D", "E minor")
+	assertSpelledScale(t, "Ab Bb C Db Eb F G", "G# major")
+	assertSpelledScale(t, "D E F G A B C", "D dorian")
+	assertSpelledScale(t, "F# G A B C# D E", "F# phrygian")
+	assertSpelledScale(t, "Bb C D E F G A", "Bb lydian")
+	assertSpelledScale(t, "A B C D E F G#", "A harmonic minor")
+	assertSpelledScale(t, "C D Eb F G A B", "C melodic minor")
+	assertSpelledScale(t, "D# E# F# G# A# B Cx", "D# harmonic minor")
+	assert.Nil(t, Key{}.SpelledScale())
+}
+
+func TestKey_Enharmonic(t *testing.T) {
+	assertEnharmonic(t, "Gb major", "F# major")
+	assertEnharmonic(t, "F# major", "Gb major")
+	assertEnharmonic(t, "Db major", "C# major")
+	assertEnharmonic(t, "C# major", "Db major")
+	assertEnharmonic(t, "Cb major", "B major")
+	assertEnharmonic(t, "B major", "Cb major")
+	assertEnharmonic(t, "Eb minor", "D# minor")
+	assertEnharmonic(t, "A# minor", "Bb minor")
+	_, ok := Of("E major").Enharmonic()
+	assert.False(t, ok)
+	_, ok = Of("C major").Enharmonic()
+	assert.False(t, ok)
+	_, ok = Key{}.Enharmonic()
+	assert.False(t, ok)
+}
+
+//
+// Private
+//
+
+func assertAccidentals(t *testing.T, expect string, s Signature) {
+	var actual []string
+	for _, a := range s.Accidentals() {
+		actual = append(actual, a.String())
+	}
+	assert.Equal(t, expect, strings.Join(actual, " "))
+}
+
+func assertSpelledScale(t *testing.T, expect string, name string) {
+	var actual []string
+	for _, tone := range Of(name).SpelledScale() {
+		actual = append(actual, tone.String())
+	}
+	assert.Equal(t, expect, strings.Join(actual, " "), name)
+}
+
+func assertEnharmonic(t *testing.T, name string, expect string) {
+	k, ok := Of(name).Enharmonic()
+	assert.True(t, ok, name)
+	assert.Equal(t, Of(expect).Root, k.Root, name)
+	assert.Equal(t, Of(expect).Mode, k.Mode, name)
+	assert.Equal(t, Of(expect).Signature(), k.Signature(), name)
+}
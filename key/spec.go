@@ -27,17 +27,38 @@ func specFrom(k Key) specKey {
 		rel := k.RelativeMajor()
 		s.Relative.Root = rel.Root.String(k.AdjSymbol)
 		s.Relative.Mode = rel.Mode.String()
+	} else if k.Root.IsChromatic() {
+		rel := k.Relative(Major)
+		s.Relative.Root = rel.Root.String(rel.AdjSymbol)
+		s.Relative.Mode = rel.Mode.String()
+	}
+	sig := k.Signature()
+	s.Signature.Sharps = sig.Sharps()
+	s.Signature.Flats = sig.Flats()
+	for _, a := range sig.Accidentals() {
+		s.Signature.Accidentals = append(s.Signature.Accidentals, a.String())
 	}
+	s.Camelot = k.Camelot()
+	s.OpenKey = k.OpenKey()
 	return s
 }
 
 type specKey struct {
-	Root     string
-	Mode     string
-	Relative specRelativeKey
+	Root      string
+	Mode      string
+	Relative  specRelativeKey
+	Signature specSignature
+	Camelot   string `yaml:",omitempty"`
+	OpenKey   string `yaml:"openkey,omitempty"`
 }
 
 type specRelativeKey struct {
 	Root string
 	Mode string
 }
+
+type specSignature struct {
+	Sharps      int
+	Flats       int
+	Accidentals []string `yaml:",omitempty"`
+}
@@ -8,8 +8,11 @@ import (
 )
 
 func TestToYAML(t *testing.T) {
-	testKeySpecYAML(t, "C major", "root: C\nmode: Major\nrelative:\n  root: A\n  mode: Minor\n")
-	testKeySpecYAML(t, "A minor", "root: A\nmode: Minor\nrelative:\n  root: C\n  mode: Major\n")
+	testKeySpecYAML(t, "C major", "root: C\nmode: Major\nrelative:\n  root: A\n  mode: Minor\nsignature:\n  sharps: 0\n  flats: 0\ncamelot: 8B\nopenkey: 1d\n")
+	testKeySpecYAML(t, "A minor", "root: A\nmode: Minor\nrelative:\n  root: C\n  mode: Major\nsignature:\n  sharps: 0\n  flats: 0\ncamelot: 8A\nopenkey: 1m\n")
+	testKeySpecYAML(t, "E major", "root: E\nmode: Major\nrelative:\n  root: C#\n  mode: Minor\nsignature:\n  sharps: 4\n  flats: 0\n  accidentals:\n  - F#\n  - C#\n  - G#\n  - D#\ncamelot: 12B\nopenkey: 5d\n")
+	testKeySpecYAML(t, "F minor", "root: F\nmode: Minor\nrelative:\n  root: Ab\n  mode: Major\nsignature:\n  sharps: 0\n  flats: 4\n  accidentals:\n  - Bb\n  - Eb\n  - Ab\n  - Db\ncamelot: 4A\nopenkey: 9m\n")
+	testKeySpecYAML(t, "G dorian", "root: G\nmode: Dorian\nrelative:\n  root: F\n  mode: Major\nsignature:\n  sharps: 0\n  flats: 1\n  accidentals:\n  - Bb\n")
 }
 
 //
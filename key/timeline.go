@@ -0,0 +1,241 @@
+// A key timeline follows the key of a piece through time, finding the key of a window of notes as it slides along the piece, with each pitch class weighted by how long it sounds, and marking the boundaries where the piece modulates from one key to another.
+package key
+
+import (
+	"math"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+const (
+	DefaultWindowSize  = 8.0
+	DefaultWindowStep  = 1.0
+	DefaultMinDuration = 8.0
+)
+
+// Window of notes in which to find the key, sliding along a piece
+type Window struct {
+	Size        float64 // Beats of notes in each window, default DefaultWindowSize
+	Step        float64 // Beats the window slides each time, default DefaultWindowStep
+	Profile     Profile // Profile of the keys to find, default KrumhanslKessler
+	MinDuration float64 // Beats over which the most common key of the window is taken, and which a new key must last before the timeline changes to it, so that a passing chord is not heard as a modulation, default DefaultMinDuration
+}
+
+// Segment of a Timeline, in one Key from its Position for its Duration, in beats
+type Segment struct {
+	Key      Key
+	Position float64
+	Duration float64
+}
+
+// Timeline of the key of a piece, in segments, each beginning where the key changes
+type Timeline []Segment
+
+// FindTimeline of the keys of the notes, by their Position and Duration in beats.
+// Each step of the Window is in the key found by the Krumhansl-Schmuckler algorithm, with the Profile of the Window, in the window of notes around it, with each pitch class weighted by its duration in the window.
+// The timeline is smoothed, by taking the most common key of the steps within the MinDuration of the Window around each step, and then continuing any key that still lasts less than the MinDuration in the longer of the keys either side of it.
+func FindTimeline(notes []*note.Note, window Window) Timeline {
+	window = window.withDefaults()
+	start, end := extentOf(notes)
+	if end <= start {
+		return nil
+	}
+
+	// the key of the window centered on each step
+	steps := make([]Key, int(math.Ceil((end-start)/window.Step)))
+	for i := range steps {
+		center := start + (float64(i)+0.5)*window.Step
+		distribution := durationDistribution(notes, center-window.Size/2, center+window.Size/2)
+		if isSilent(distribution) {
+			continue
+		}
+		if ranked := rankKeysOf(distribution, window.Profile); len(ranked) > 0 {
+			steps[i] = ranked[0].Key
+		}
+	}
+	if !filled(steps) {
+		return nil
+	}
+	minSteps := int(math.Ceil(window.MinDuration / window.Step))
+	runs := smoothed(runsOf(mostCommon(steps, minSteps)), minSteps)
+
+	var timeline Timeline
+	offset := 0
+	for _, r := range runs {
+		position := start + float64(offset)*window.Step
+		offset += r.steps
+		timeline = append(timeline, Segment{
+			Key:      r.key,
+			Position: position,
+			Duration: math.Min(start+float64(offset)*window.Step, end) - position,
+		})
+	}
+	return timeline
+}
+
+// KeyAt a position in beats, or the zero Key outside of the Timeline
+func (this Timeline) KeyAt(position float64) Key {
+	for _, s := range this {
+		if position >= s.Position && position < s.Position+s.Duration {
+			return s.Key
+		}
+	}
+	return Key{}
+}
+
+//
+// Private
+//
+
+// keyRun of consecutive steps of a window in the same key
+type keyRun struct {
+	key   Key
+	steps int
+}
+
+func (this Window) withDefaults() Window {
+	if this.Size <= 0 {
+		this.Size = DefaultWindowSize
+	}
+	if this.Step <= 0 {
+		this.Step = DefaultWindowStep
+	}
+	if this.Profile == nil {
+		this.Profile = KrumhanslKessler
+	}
+	if this.MinDuration <= 0 {
+		this.MinDuration = DefaultMinDuration
+	}
+	return this
+}
+
+// extentOf the notes, from the beginning of the first to the end of the last
+func extentOf(notes []*note.Note) (start float64, end float64) {
+	for i, n := range notes {
+		if i == 0 || n.Position < start {
+			start = n.Position
+		}
+		if i == 0 || n.Position+n.Duration > end {
+			end = n.Position + n.Duration
+		}
+	}
+	return
+}
+
+// durationDistribution of the pitch classes of the notes, each weighted by how many of its beats sound from one position to another
+func durationDistribution(notes []*note.Note, from float64, to float64) []float64 {
+	distribution := make([]float64, 12)
+	for _, n := range notes {
+		if !n.Class.IsChromatic() {
+			continue
+		}
+		overlap := math.Min(n.Position+n.Duration, to) - math.Max(n.Position, from)
+		if overlap > 0 {
+			distribution[classToSemitone(n.Class)] += overlap
+		}
+	}
+	return distribution
+}
+
+func isSilent(distribution []float64) bool {
+	for _, d := range distribution {
+		if d > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// filled steps, in which a step without a key, in a rest, continues the key before it, or after it at the beginning; false if no step has a key
+func filled(steps []Key) bool {
+	first := -1
+	for i := range steps {
+		switch {
+		case steps[i] != Key{} && first < 0:
+			first = i
+		case steps[i] == Key{} && first >= 0:
+			steps[i] = steps[i-1]
+		}
+	}
+	if first < 0 {
+		return false
+	}
+	for i := 0; i < first; i++ {
+		steps[i] = steps[first]
+	}
+	return true
+}
+
+// mostCommon key of the steps around each step, over the given number of steps, keeping the key of the step itself in a tie
+func mostCommon(steps []Key, over int) []Key {
+	common := make([]Key, len(steps))
+	for i := range steps {
+		from := i - over/2
+		if from < 0 {
+			from = 0
+		}
+		to := i + over/2
+		if to > len(steps)-1 {
+			to = len(steps) - 1
+		}
+		count := make(map[Key]int)
+		for _, k := range steps[from : to+1] {
+			count[k]++
+		}
+		common[i] = steps[i]
+		for _, k := range steps[from : to+1] {
+			if count[k] > count[common[i]] {
+				common[i] = k
+			}
+		}
+	}
+	return common
+}
+
+// runsOf the keys of consecutive steps
+func runsOf(steps []Key) (runs []keyRun) {
+	for _, k := range steps {
+		last := len(runs) - 1
+		if last >= 0 && runs[last].key == k {
+			runs[last].steps++
+		} else {
+			runs = append(runs, keyRun{key: k, steps: 1})
+		}
+	}
+	return
+}
+
+// smoothed runs, in which each run of fewer than the minimum steps, shortest first, continues the longer of the runs either side of it
+func smoothed(runs []keyRun, minSteps int) []keyRun {
+	for len(runs) > 1 {
+		shortest := -1
+		for i, r := range runs {
+			if r.steps < minSteps && (shortest < 0 || r.steps < runs[shortest].steps) {
+				shortest = i
+			}
+		}
+		if shortest < 0 {
+			break
+		}
+		into := shortest - 1
+		if into < 0 || (shortest+1 < len(runs) && runs[shortest+1].steps > runs[into].steps) {
+			into = shortest + 1
+		}
+		runs[into].steps += runs[shortest].steps
+		runs = merged(append(runs[:shortest], runs[shortest+1:]...))
+	}
+	return runs
+}
+
+// merged runs, in which consecutive runs of the same key are one
+func merged(runs []keyRun) (merge []keyRun) {
+	for _, r := range runs {
+		last := len(merge) - 1
+		if last >= 0 && merge[last].key == r.key {
+			merge[last].steps += r.steps
+		} else {
+			merge = append(merge, r)
+		}
+	}
+	return
+}
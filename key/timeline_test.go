@@ -0,0 +1,128 @@
+// A key timeline follows the key of a piece through time, finding the key of a window of notes as it slides along the piece, with each pitch class weighted by how long it sounds, and marking the boundaries where the piece modulates from one key to another.
+package key
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestFindTimeline(t *testing.T) {
+	notes := testMelody(0, "C4 D4 E4 F4 G4 A4 B4 C5 G4 E4 C4 G4 C4 E4 G4 C5")
+	notes = append(notes, testMelody(16, "A4 B4 C#5 D5 E5 F#5 G#5 A5 E5 C#5 A4 E5 A4 C#5 E5 A5")...)
+	timeline := FindTimeline(notes, Window{})
+
+	assert.Equal(t, 2, len(timeline))
+	assert.Equal(t, Key{Root: note.C, AdjSymbol: note.Sharp, Mode: Major}, timeline[0].Key)
+	assert.Equal(t, 0.0, timeline[0].Position)
+	assert.Equal(t, Key{Root: note.A, AdjSymbol: note.Sharp, Mode: Major}, timeline[1].Key)
+	assert.InDelta(t, 16, timeline[1].Position, 3)
+	assert.Equal(t, 32.0, timeline[1].Position+timeline[1].Duration)
+	assert.Equal(t, timeline[0].Position+timeline[0].Duration, timeline[1].Position)
+}
+
+func TestFindTimeline_Smoothing(t *testing.T) {
+	// a passing chord of E major is not a modulation
+	notes := testMelody(0, "C4 E4 G4 C5 G4 E4 C4 E4 G4 C5 B4 D5 F4 A4 C5 G4")
+	notes = append(notes, testMelody(16, "E4 G#4 B4 G#4")...)
+	notes = append(notes, testMelody(20, "C4 E4 G4 C5 G4 E4 C4 G4 D4 F4 B4 G4 C4 E4 G4 C5")...)
+	timeline := FindTimeline(notes, Window{})
+	assert.Equal(t, 1, len(timeline))
+	assert.Equal(t, Key{Root: note.C, AdjSymbol: note.Sharp, Mode: Major}, timeline[0].Key)
+	assert.Equal(t, 36.0, timeline[0].Duration)
+
+	// without smoothing, the key flickers
+	unsmoothed := FindTimeline(notes, Window{MinDuration: 0.5})
+	assert.True(t, len(unsmoothed) > 3)
+}
+
+func TestFindTimeline_DurationWeighted(t *testing.T) {
+	// the long A, C and E outweigh the passing G, B and D
+	var notes []*note.Note
+	var classes []note.Class
+	position := 0.0
+	for i := 0; i < 3; i++ {
+		for _, n := range []*note.Note{
+			{Class: note.A, Duration: 3},
+			{Class: note.G, Duration: 0.25},
+			{Class: note.C, Duration: 3},
+			{Class: note.B, Duration: 0.25},
+			{Class: note.E, Duration: 3},
+			{Class: note.D, Duration: 0.25},
+		} {
+			n.Position = position
+			position += n.Duration
+			notes = append(notes, n)
+			classes = append(classes, n.Class)
+		}
+	}
+	timeline := FindTimeline(notes, Window{})
+	assert.Equal(t, 1, len(timeline))
+	assert.Equal(t, Key{Root: note.A, AdjSymbol: note.Sharp, Mode: Minor}, timeline[0].Key)
+	assert.Equal(t, 29.25, timeline[0].Duration)
+	assert.NotEqual(t, timeline[0].Key, FindKey(classes))
+}
+
+func TestFindTimeline_Empty(t *testing.T) {
+	assert.Nil(t, FindTimeline(nil, Window{}))
+	assert.Nil(t, FindTimeline([]*note.Note{{Class: note.C, Position: 4}}, Window{}))
+	assert.Nil(t, FindTimeline([]*note.Note{{Class: note.Nil, Position: 0, Duration: 4}}, Window{}))
+}
+
+func TestTimeline_KeyAt(t *testing.T) {
+	timeline := Timeline{
+		{Key: Of("C"), Position: 0, Duration: 8},
+		{Key: Of("G"), Position: 8, Duration: 8},
+	}
+	assert.Equal(t, Of("C"), timeline.KeyAt(0))
+	assert.Equal(t, Of("C"), timeline.KeyAt(7.5))
+	assert.Equal(t, Of("G"), timeline.KeyAt(8))
+	assert.Equal(t, Key{}, timeline.KeyAt(16))
+	assert.Equal(t, Key{}, timeline.KeyAt(-1))
+}
+
+func Test_filled(t *testing.T) {
+	c, g := Of("C"), Of("G")
+	steps := []Key{{}, c, {}, g, {}}
+	assert.True(t, filled(steps))
+	assert.Equal(t, []Key{c, c, c, g, g}, steps)
+	assert.False(t, filled([]Key{{}, {}}))
+}
+
+func Test_mostCommon(t *testing.T) {
+	c, g := Of("C"), Of("G")
+	assert.Equal(t, []Key{c, c, c, c, c}, mostCommon([]Key{c, c, g, c, c}, 4))
+	// in a tie, each step keeps its own key
+	assert.Equal(t, []Key{c, c, g, g}, mostCommon([]Key{c, c, g, g}, 4))
+	assert.Equal(t, []Key{g, g, c, c}, mostCommon([]Key{g, g, c, c}, 4))
+}
+
+func Test_smoothed(t *testing.T) {
+	c, g, a := Of("C"), Of("G"), Of("A minor")
+	assert.Equal(t, []keyRun{{c, 12}, {g, 8}}, smoothed([]keyRun{{c, 8}, {a, 2}, {c, 2}, {g, 8}}, 4))
+	assert.Equal(t, []keyRun{{c, 9}, {g, 8}}, smoothed([]keyRun{{a, 1}, {c, 8}, {g, 8}}, 4))
+	assert.Equal(t, []keyRun{{c, 3}}, smoothed([]keyRun{{c, 2}, {g, 1}}, 4))
+}
+
+func TestWindow_withDefaults(t *testing.T) {
+	assert.Equal(t, Window{Size: 8, Step: 1, Profile: KrumhanslKessler, MinDuration: 8}, Window{}.withDefaults())
+	assert.Equal(t, Window{Size: 4, Step: 0.5, Profile: Temperley, MinDuration: 2}, Window{Size: 4, Step: 0.5, Profile: Temperley, MinDuration: 2}.withDefaults())
+}
+
+//
+// Private
+//
+
+// testMelody of one-beat notes from the given position
+func testMelody(position float64, names string) (notes []*note.Note) {
+	for i, name := range strings.Fields(names) {
+		n := note.Named(name)
+		n.Position = position + float64(i)
+		n.Duration = 1
+		notes = append(notes, n)
+	}
+	return
+}
@@ -0,0 +1,68 @@
+package midi_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// ExampleReadFile demonstrates reading the notes of each track of a MIDI file, and finding the key of all its notes
+func ExampleReadFile() {
+	f, err := midi.ReadFile("testdata/melody.mid")
+	if err != nil {
+		panic(err)
+	}
+	for _, track := range f.Tracks {
+		fmt.Printf("%s:", track.Name)
+		for _, n := range track.Notes {
+			fmt.Printf(" %s%d@%g", n.Class.String(note.Sharp), n.Octave, n.Position)
+		}
+		fmt.Println()
+	}
+	var classes []note.Class
+	for _, n := range f.Notes() {
+		classes = append(classes, n.Class)
+	}
+	k := key.FindKey(classes)
+	fmt.Printf("Key: %s %s\n", k.Root.String(k.AdjSymbol), k.Mode)
+	// Output:
+	// Tempo:
+	// Melody: A4@0 C5@1 E5@2 D5@3 C5@4 B4@5 A4@6
+	// Bass: A2@0 E3@4 A2@6
+	// Key: A Minor
+}
+
+// ExampleWrite demonstrates writing a voiced chord for one bar, in a key
+func ExampleWrite() {
+	notes, err := chord.Of("Cmaj9").Voice(chord.Voicing{})
+	if err != nil {
+		panic(err)
+	}
+	for _, n := range notes {
+		n.Duration = 4
+	}
+	var data bytes.Buffer
+	if err := midi.Write(&data, notes, midi.Meta{BPM: 96, Key: key.Of("C")}); err != nil {
+		panic(err)
+	}
+
+	f, err := midi.Read(&data)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Tempo: %g BPM\n", f.Tempos[0].BPM)
+	for _, n := range f.Tracks[1].Notes {
+		fmt.Printf("%s%d for %g beats\n", n.Class.String(note.Sharp), n.Octave, n.Duration)
+	}
+	// Output:
+	// Tempo: 96 BPM
+	// C4 for 4 beats
+	// E4 for 4 beats
+	// G4 for 4 beats
+	// B4 for 4 beats
+	// D5 for 4 beats
+}
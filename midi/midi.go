@@ -0,0 +1,100 @@
+// A Standard MIDI File holds the tracks of a recorded or sequenced performance, each a series of timed events such as notes, tempo changes and track names.
+//
+// https://en.wikipedia.org/wiki/MIDI#Standard_MIDI_files
+//
+// Files of format 0 (a single track) and format 1 (several simultaneous tracks) are read into Notes, positioned in beats, and Notes are written to files of format 1, with a key signature from a Key.
+//
+// # Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+package midi
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+var (
+	ErrHeader    = errors.New("not a standard MIDI file")
+	ErrFormat    = errors.New("unsupported MIDI file format")
+	ErrTruncated = errors.New("truncated MIDI file")
+	ErrEvent     = errors.New("invalid MIDI event")
+)
+
+// File read from a Standard MIDI File
+type File struct {
+	Format int      // Format 0 has a single track, format 1 has several simultaneous tracks
+	PPQ    int      // Pulses (ticks) per quarter note, or 0 if the file is timed in SMPTE frames
+	Tracks []*Track // Tracks in the order they appear in the file
+	Tempos []Tempo  // Tempo changes, in order; the tempo is 120 BPM until the first
+}
+
+// Track of Notes, named by its track name meta event, if any
+type Track struct {
+	Name  string
+	Notes []*note.Note // Notes in order of Position, each with the track Name as its Performer
+}
+
+// Tempo change at a position in beats
+type Tempo struct {
+	Beat float64
+	BPM  float64
+}
+
+// Read a Standard MIDI File of format 0 or 1.
+// Note positions and durations are in beats (quarter notes), by the PPQ of the file, or by its tempo map if the file is timed in SMPTE frames.
+// A note on with velocity 0 ends a note, as a note off; a note never ended lasts until the end of its track.
+func Read(r io.Reader) (*File, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parse(data)
+}
+
+// ReadFile at the given path, see Read
+func ReadFile(path string) (*File, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Read(bytes.NewReader(data))
+}
+
+// Notes of all Tracks together, in order of Position, e.g. to find the key of the whole File
+func (this *File) Notes() (notes []*note.Note) {
+	for _, track := range this.Tracks {
+		notes = append(notes, track.Notes...)
+	}
+	sortByPosition(notes)
+	return
+}
+
+// Classes of the Notes of the Track, in order of Position, e.g. for key.FindKey
+func (this *Track) Classes() (classes []note.Class) {
+	for _, n := range this.Notes {
+		classes = append(classes, n.Class)
+	}
+	return
+}
+
+//
+// Private
+//
+
+// sortByPosition keeps notes of the same position in their original order
+func sortByPosition(notes []*note.Note) {
+	sort.SliceStable(notes, func(i, j int) bool {
+		return notes[i].Position < notes[j].Position
+	})
+}
@@ -0,0 +1,170 @@
+// A Standard MIDI File holds the tracks of a recorded or sequenced performance, each a series of timed events such as notes, tempo changes and track names.
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRead_Format0(t *testing.T) {
+	data := testFile(0, 480, testTrack(
+		0, []byte{0xFF, 0x03, 5}, []byte("Piano"),
+		0, []byte{0x90, 60, 100},
+		480, []byte{0x80, 60, 0},
+		0, []byte{0x91, 64, 90},
+		240, []byte{0x91, 64, 0},
+	))
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, f.Format)
+	assert.Equal(t, 480, f.PPQ)
+	assert.Equal(t, 1, len(f.Tracks))
+	assert.Equal(t, "Piano", f.Tracks[0].Name)
+	assert.Equal(t, []*note.Note{
+		{Class: note.C, Octave: 4, Performer: "Piano", Position: 0, Duration: 1, Velocity: 100, Channel: 0},
+		{Class: note.E, Octave: 4, Performer: "Piano", Position: 1, Duration: 0.5, Velocity: 90, Channel: 1},
+	}, f.Tracks[0].Notes)
+}
+
+func TestRead_Format1(t *testing.T) {
+	data := testFile(1, 96,
+		testTrack(
+			0, []byte{0xFF, 0x51, 3, 0x07, 0xA1, 0x20}, // 120 BPM
+			192, []byte{0xFF, 0x51, 3, 0x0F, 0x42, 0x40}, // 60 BPM
+		),
+		testTrack(
+			0, []byte{0xFF, 0x03, 4}, []byte("Bass"),
+			96, []byte{0x90, 36, 80},
+			96, []byte{0x80, 36, 64},
+		),
+		testTrack(
+			0, []byte{0xFF, 0x03, 4}, []byte("Lead"),
+			0, []byte{0x90, 72, 100},
+			0, []byte{0x90, 76, 100},
+			48, []byte{0x80, 72, 0},
+			48, []byte{0x80, 76, 0},
+		),
+	)
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, f.Format)
+	assert.Equal(t, []Tempo{{Beat: 0, BPM: 120}, {Beat: 2, BPM: 60}}, f.Tempos)
+	assert.Equal(t, 3, len(f.Tracks))
+	assert.Equal(t, "", f.Tracks[0].Name)
+	assert.Equal(t, 0, len(f.Tracks[0].Notes))
+	assert.Equal(t, []*note.Note{
+		{Class: note.C, Octave: 2, Performer: "Bass", Position: 1, Duration: 1, Velocity: 80},
+	}, f.Tracks[1].Notes)
+	assert.Equal(t, []*note.Note{
+		{Class: note.C, Octave: 5, Performer: "Lead", Position: 0, Duration: 0.5, Velocity: 100},
+		{Class: note.E, Octave: 5, Performer: "Lead", Position: 0, Duration: 1, Velocity: 100},
+	}, f.Tracks[2].Notes)
+}
+
+func TestRead_Format2(t *testing.T) {
+	_, err := Read(bytes.NewReader(testFile(2, 96, testTrack())))
+
+	assert.Equal(t, ErrFormat, err)
+}
+
+func TestRead_NotMIDI(t *testing.T) {
+	_, err := Read(bytes.NewReader([]byte("RIFF....WAVEfmt ")))
+
+	assert.Equal(t, ErrHeader, err)
+}
+
+func TestRead_Truncated(t *testing.T) {
+	data := testFile(0, 96, testTrack(0, []byte{0x90, 60, 100}))
+
+	_, err := Read(bytes.NewReader(data[:len(data)-2]))
+
+	assert.Equal(t, ErrTruncated, err)
+}
+
+func TestReadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "midi")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.mid")
+	assert.Nil(t, ioutil.WriteFile(path, testFile(0, 96, testTrack(0, []byte{0x90, 69, 100}, 96, []byte{0x80, 69, 0})), 0644))
+
+	f, err := ReadFile(path)
+
+	assert.Nil(t, err)
+	assert.Equal(t, note.A, f.Tracks[0].Notes[0].Class)
+	assert.Equal(t, note.Octave(4), f.Tracks[0].Notes[0].Octave)
+	assert.Equal(t, 69, f.Tracks[0].Notes[0].MIDI())
+}
+
+func TestReadFile_Missing(t *testing.T) {
+	_, err := ReadFile("does/not/exist.mid")
+
+	assert.NotNil(t, err)
+}
+
+func TestFile_Notes(t *testing.T) {
+	data := testFile(1, 96,
+		testTrack(0, []byte{0x90, 67, 100}, 192, []byte{0x80, 67, 0}),
+		testTrack(96, []byte{0x90, 64, 100}, 96, []byte{0x80, 64, 0}),
+		testTrack(0, []byte{0x90, 60, 100}, 96, []byte{0x80, 60, 0}),
+	)
+	f, err := Read(bytes.NewReader(data))
+	assert.Nil(t, err)
+
+	notes := f.Notes()
+
+	assert.Equal(t, 3, len(notes))
+	assert.Equal(t, note.G, notes[0].Class)
+	assert.Equal(t, note.C, notes[1].Class)
+	assert.Equal(t, note.E, notes[2].Class)
+}
+
+func TestTrack_Classes(t *testing.T) {
+	track := &Track{Notes: []*note.Note{note.Named("D4"), note.Named("F#4"), note.Named("A4")}}
+
+	assert.Equal(t, []note.Class{note.D, note.Fs, note.A}, track.Classes())
+}
+
+//
+// Private
+//
+
+// testFile of the given format and division, of the given track chunks
+func testFile(format int, division int, tracks ...[]byte) []byte {
+	header := make([]byte, 6)
+	binary.BigEndian.PutUint16(header[0:2], uint16(format))
+	binary.BigEndian.PutUint16(header[2:4], uint16(len(tracks)))
+	binary.BigEndian.PutUint16(header[4:6], uint16(division))
+	data := chunkOf("MThd", header)
+	for _, track := range tracks {
+		data = append(data, track...)
+	}
+	return data
+}
+
+// testTrack chunk of delta times, each followed by the bytes of an event, ending with an end of track meta event
+func testTrack(events ...interface{}) []byte {
+	var data []byte
+	for _, e := range events {
+		switch v := e.(type) {
+		case int:
+			data = append(data, varLen(v)...)
+		case []byte:
+			data = append(data, v...)
+		}
+	}
+	data = append(data, 0, 0xFF, 0x2F, 0)
+	return chunkOf("MTrk", data)
+}
@@ -0,0 +1,289 @@
+// Reading a Standard MIDI File walks its header chunk and track chunks, pairing each note on with its note off, and then converts ticks to beats by the file's timing.
+package midi
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+//
+// Private
+//
+
+// defaultTempo in microseconds per quarter note, i.e. 120 BPM
+const defaultTempo = 500000
+
+// chunk of a Standard MIDI File
+type chunk struct {
+	kind string
+	data []byte
+}
+
+// tempoChange at a tick, in microseconds per quarter note
+type tempoChange struct {
+	tick   int
+	micros int
+}
+
+// tickNote played from one tick to another
+type tickNote struct {
+	start, end        int
+	number            int
+	velocity, channel int
+}
+
+// tickTrack of a Standard MIDI File, before conversion from ticks to beats
+type tickTrack struct {
+	name  string
+	notes []*tickNote
+}
+
+// timing of a Standard MIDI File, by which ticks are converted to beats
+type timing struct {
+	ppq            int
+	ticksPerSecond float64
+	tempos         []tempoChange
+}
+
+func parse(data []byte) (*File, error) {
+	header, rest, err := readChunk(data)
+	if err != nil || header.kind != "MThd" || len(header.data) < 6 {
+		return nil, ErrHeader
+	}
+	format := int(binary.BigEndian.Uint16(header.data[0:2]))
+	count := int(binary.BigEndian.Uint16(header.data[2:4]))
+	division := binary.BigEndian.Uint16(header.data[4:6])
+	if format > 1 {
+		return nil, ErrFormat
+	}
+
+	var t timing
+	if division&0x8000 == 0 {
+		t.ppq = int(division)
+		if t.ppq == 0 {
+			return nil, ErrHeader
+		}
+	} else {
+		// SMPTE frames per second, as a negative byte, and ticks per frame
+		fps := -int(int8(division >> 8))
+		if fps == 29 {
+			t.ticksPerSecond = 29.97 * float64(division&0xFF)
+		} else {
+			t.ticksPerSecond = float64(fps) * float64(division&0xFF)
+		}
+		if t.ticksPerSecond <= 0 {
+			return nil, ErrHeader
+		}
+	}
+
+	var tracks []*tickTrack
+	for len(tracks) < count && len(rest) > 0 {
+		var c chunk
+		c, rest, err = readChunk(rest)
+		if err != nil {
+			return nil, err
+		}
+		if c.kind != "MTrk" {
+			continue // chunks of unknown kind are to be ignored
+		}
+		track, tempos, err := readTrack(c.data)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, track)
+		t.tempos = append(t.tempos, tempos...)
+	}
+	if len(tracks) < count {
+		return nil, ErrTruncated
+	}
+	sort.SliceStable(t.tempos, func(i, j int) bool {
+		return t.tempos[i].tick < t.tempos[j].tick
+	})
+
+	f := &File{Format: format, PPQ: t.ppq}
+	for _, tempo := range t.tempos {
+		f.Tempos = append(f.Tempos, Tempo{Beat: t.beatsAt(tempo.tick), BPM: 60000000 / float64(tempo.micros)})
+	}
+	for _, track := range tracks {
+		f.Tracks = append(f.Tracks, t.trackOf(track))
+	}
+	return f, nil
+}
+
+// readChunk of a type and length, returning the rest of the data after it
+func readChunk(data []byte) (chunk, []byte, error) {
+	if len(data) < 8 {
+		return chunk{}, nil, ErrTruncated
+	}
+	length := int(binary.BigEndian.Uint32(data[4:8]))
+	if length < 0 || len(data)-8 < length {
+		return chunk{}, nil, ErrTruncated
+	}
+	return chunk{kind: string(data[0:4]), data: data[8 : 8+length]}, data[8+length:], nil
+}
+
+// readTrack events, pairing the note on and note off of each channel and note number in order
+func readTrack(data []byte) (*tickTrack, []tempoChange, error) {
+	track := &tickTrack{}
+	var tempos []tempoChange
+	sounding := make(map[[2]int][]*tickNote)
+	tick, status, pos := 0, byte(0), 0
+	for pos < len(data) {
+		delta, n, err := readVarLen(data[pos:])
+		if err != nil {
+			return nil, nil, err
+		}
+		tick += delta
+		pos += n
+		if pos >= len(data) {
+			return nil, nil, ErrTruncated
+		}
+
+		if data[pos] >= 0x80 {
+			status = data[pos]
+			pos++
+		} else if status == 0 {
+			return nil, nil, ErrEvent // a data byte without any running status
+		}
+
+		switch {
+		case status == 0xFF: // meta event
+			if pos >= len(data) {
+				return nil, nil, ErrTruncated
+			}
+			kind := data[pos]
+			length, n, err := readVarLen(data[pos+1:])
+			if err != nil {
+				return nil, nil, err
+			}
+			pos += 1 + n
+			if len(data)-pos < length {
+				return nil, nil, ErrTruncated
+			}
+			body := data[pos : pos+length]
+			pos += length
+			status = 0
+			switch kind {
+			case 0x03: // track name
+				if track.name == "" {
+					track.name = string(body)
+				}
+			case 0x51: // tempo
+				if length == 3 {
+					micros := int(body[0])<<16 | int(body[1])<<8 | int(body[2])
+					if micros > 0 {
+						tempos = append(tempos, tempoChange{tick, micros})
+					}
+				}
+			case 0x2F: // end of track
+				pos = len(data)
+			}
+
+		case status == 0xF0 || status == 0xF7: // system exclusive
+			length, n, err := readVarLen(data[pos:])
+			if err != nil {
+				return nil, nil, err
+			}
+			pos += n
+			if len(data)-pos < length {
+				return nil, nil, ErrTruncated
+			}
+			pos += length
+			status = 0
+
+		case status >= 0xF0:
+			return nil, nil, ErrEvent
+
+		default: // channel event
+			size := 2
+			if kind := status & 0xF0; kind == 0xC0 || kind == 0xD0 {
+				size = 1
+			}
+			if len(data)-pos < size {
+				return nil, nil, ErrTruncated
+			}
+			event := data[pos : pos+size]
+			pos += size
+			channel := int(status & 0x0F)
+			switch status & 0xF0 {
+			case 0x90:
+				if event[1] > 0 {
+					tn := &tickNote{start: tick, end: -1, number: int(event[0]), velocity: int(event[1]), channel: channel}
+					key := [2]int{channel, tn.number}
+					sounding[key] = append(sounding[key], tn)
+					track.notes = append(track.notes, tn)
+					break
+				}
+				fallthrough // note on with velocity 0 is a note off
+			case 0x80:
+				key := [2]int{channel, int(event[0])}
+				if on := sounding[key]; len(on) > 0 {
+					on[0].end = tick
+					sounding[key] = on[1:]
+				}
+			}
+		}
+	}
+
+	for _, tn := range track.notes {
+		if tn.end < 0 {
+			tn.end = tick
+		}
+	}
+	return track, tempos, nil
+}
+
+// readVarLen quantity of up to four bytes, returning its value and the number of bytes read
+func readVarLen(data []byte) (value int, n int, err error) {
+	for n < len(data) && n < 4 {
+		b := data[n]
+		n++
+		value = value<<7 | int(b&0x7F)
+		if b&0x80 == 0 {
+			return value, n, nil
+		}
+	}
+	if n == 4 {
+		return 0, 0, ErrEvent
+	}
+	return 0, 0, ErrTruncated
+}
+
+// trackOf notes in beats
+func (this timing) trackOf(tt *tickTrack) *Track {
+	track := &Track{Name: tt.name}
+	for _, tn := range tt.notes {
+		n := note.FromMIDI(tn.number, note.No)
+		n.Performer = tt.name
+		n.Position = this.beatsAt(tn.start)
+		n.Duration = this.beatsAt(tn.end) - n.Position
+		n.Velocity = tn.velocity
+		n.Channel = tn.channel
+		track.Notes = append(track.Notes, n)
+	}
+	sortByPosition(track.Notes)
+	return track
+}
+
+// beatsAt a tick, counted in quarter notes by the PPQ, or else by the tempo map from the time in seconds
+func (this timing) beatsAt(tick int) float64 {
+	if this.ppq > 0 {
+		return float64(tick) / float64(this.ppq)
+	}
+	beats, from, micros := 0.0, 0, defaultTempo
+	for _, tempo := range this.tempos {
+		if tempo.tick >= tick {
+			break
+		}
+		beats += this.beatsBetween(from, tempo.tick, micros)
+		from, micros = tempo.tick, tempo.micros
+	}
+	return beats + this.beatsBetween(from, tick, micros)
+}
+
+// beatsBetween two ticks at a constant tempo in microseconds per quarter note
+func (this timing) beatsBetween(from int, to int, micros int) float64 {
+	return float64(to-from) / this.ticksPerSecond * 1000000 / float64(micros)
+}
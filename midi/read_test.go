@@ -0,0 +1,124 @@
+// Reading a Standard MIDI File walks its header chunk and track chunks, pairing each note on with its note off, and then converts ticks to beats by the file's timing.
+package midi
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestRead_RunningStatus(t *testing.T) {
+	data := testFile(0, 96, testTrack(
+		0, []byte{0x90, 60, 100},
+		0, []byte{64, 100}, // running status note on
+		96, []byte{60, 0}, // running status note on with velocity 0
+		0, []byte{64, 0},
+	))
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(f.Tracks[0].Notes))
+	assert.Equal(t, note.C, f.Tracks[0].Notes[0].Class)
+	assert.Equal(t, 1.0, f.Tracks[0].Notes[0].Duration)
+	assert.Equal(t, note.E, f.Tracks[0].Notes[1].Class)
+	assert.Equal(t, 1.0, f.Tracks[0].Notes[1].Duration)
+}
+
+func TestRead_RunningStatusWithoutStatus(t *testing.T) {
+	_, err := Read(bytes.NewReader(testFile(0, 96, testTrack(0, []byte{60, 100}))))
+
+	assert.Equal(t, ErrEvent, err)
+}
+
+func TestRead_OverlappingSameNote(t *testing.T) {
+	data := testFile(0, 96, testTrack(
+		0, []byte{0x90, 60, 100},
+		48, []byte{0x90, 60, 50},
+		48, []byte{0x80, 60, 0},
+		96, []byte{0x80, 60, 0},
+	))
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(f.Tracks[0].Notes))
+	assert.Equal(t, 1.0, f.Tracks[0].Notes[0].Duration)
+	assert.Equal(t, 100, f.Tracks[0].Notes[0].Velocity)
+	assert.Equal(t, 1.5, f.Tracks[0].Notes[1].Duration)
+	assert.Equal(t, 50, f.Tracks[0].Notes[1].Velocity)
+}
+
+func TestRead_UnterminatedNote(t *testing.T) {
+	data := testFile(0, 96, testTrack(
+		0, []byte{0x90, 60, 100},
+		384, []byte{0xFF, 0x01, 0}, // empty text event
+	))
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4.0, f.Tracks[0].Notes[0].Duration)
+}
+
+func TestRead_SkipsOtherEvents(t *testing.T) {
+	data := testFile(0, 96, testTrack(
+		0, []byte{0xF0, 3, 0x7E, 0x7F, 0xF7}, // system exclusive
+		0, []byte{0xC0, 5}, // program change
+		0, []byte{0xB0, 7, 100}, // control change
+		0, []byte{0x90, 62, 100},
+		0, []byte{0xD0, 40}, // channel pressure
+		96, []byte{0x80, 62, 0},
+	))
+	unknown := chunkOf("XFIH", []byte{1, 2, 3})
+	data = append(data[:14], append(unknown, data[14:]...)...)
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(f.Tracks[0].Notes))
+	assert.Equal(t, note.D, f.Tracks[0].Notes[0].Class)
+	assert.Equal(t, 1.0, f.Tracks[0].Notes[0].Duration)
+}
+
+func TestRead_SMPTE(t *testing.T) {
+	// 25 frames per second of 40 ticks each, i.e. 1000 ticks per second
+	division := 0xE728
+	data := testFile(0, division, testTrack(
+		0, []byte{0x90, 60, 100},
+		1000, []byte{0x80, 60, 0}, // 2 beats at 120 BPM
+		0, []byte{0xFF, 0x51, 3, 0x0F, 0x42, 0x40}, // 60 BPM
+		0, []byte{0x90, 62, 100},
+		1000, []byte{0x80, 62, 0}, // 1 beat at 60 BPM
+	))
+
+	f, err := Read(bytes.NewReader(data))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, f.PPQ)
+	assert.Equal(t, []Tempo{{Beat: 2, BPM: 60}}, f.Tempos)
+	assert.Equal(t, 0.0, f.Tracks[0].Notes[0].Position)
+	assert.Equal(t, 2.0, f.Tracks[0].Notes[0].Duration)
+	assert.Equal(t, 2.0, f.Tracks[0].Notes[1].Position)
+	assert.Equal(t, 1.0, f.Tracks[0].Notes[1].Duration)
+}
+
+func TestReadVarLen(t *testing.T) {
+	for _, value := range []int{0, 0x40, 0x7F, 0x80, 0x2000, 0x3FFF, 0x4000, 0x1FFFFF, 0x200000, 0x0FFFFFFF} {
+		v, n, err := readVarLen(varLen(value))
+		assert.Nil(t, err)
+		assert.Equal(t, value, v)
+		assert.Equal(t, len(varLen(value)), n)
+	}
+}
+
+func TestReadVarLen_Invalid(t *testing.T) {
+	_, _, err := readVarLen([]byte{0x81, 0x80})
+	assert.Equal(t, ErrTruncated, err)
+
+	_, _, err = readVarLen([]byte{0x81, 0x80, 0x80, 0x80, 0x00})
+	assert.Equal(t, ErrEvent, err)
+}
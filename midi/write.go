@@ -0,0 +1,183 @@
+// Writing a Standard MIDI File places each note on and note off at a tick by the PPQ, after a conductor track of tempo, time signature and key signature meta events.
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Meta of a written Standard MIDI File, its timing and signatures
+type Meta struct {
+	PPQ         int     // Pulses (ticks) per quarter note, default DefaultPPQ
+	BPM         float64 // Tempo in quarter notes per minute, default DefaultBPM
+	BeatsPerBar int     // Time signature numerator, default 4
+	BeatUnit    int     // Time signature denominator, a power of 2, default 4
+	Key         key.Key // Key signature, none for the zero Key
+}
+
+const (
+	DefaultPPQ      = 480
+	DefaultBPM      = 120.0
+	DefaultVelocity = 100
+)
+
+// Write the notes as a Standard MIDI File of format 1, with positions and durations in beats (quarter notes).
+// The first track holds the tempo, time signature and key signature; after it, the notes of each Performer are written to a track named for them, in the order in which the Performers first appear.
+// A note without Velocity is written with DefaultVelocity; microtonal notes cannot be written, and are omitted.
+func Write(w io.Writer, notes []*note.Note, m Meta) error {
+	m = m.withDefaults()
+	var data bytes.Buffer
+	data.Write(chunkOf("MThd", uint16Bytes(1, uint16(1+len(performersOf(notes))), uint16(m.PPQ))))
+	data.Write(chunkOf("MTrk", m.conductor()))
+	for _, performer := range performersOf(notes) {
+		data.Write(chunkOf("MTrk", trackData(performer, notes, m.PPQ)))
+	}
+	_, err := w.Write(data.Bytes())
+	return err
+}
+
+// WriteFile at the given path, see Write
+func WriteFile(path string, notes []*note.Note, m Meta) error {
+	var data bytes.Buffer
+	if err := Write(&data, notes, m); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data.Bytes(), 0644)
+}
+
+//
+// Private
+//
+
+// withDefaults for any unset timing
+func (this Meta) withDefaults() Meta {
+	if this.PPQ <= 0 || this.PPQ > 0x7FFF {
+		this.PPQ = DefaultPPQ
+	}
+	if this.BPM <= 0 {
+		this.BPM = DefaultBPM
+	}
+	if this.BeatsPerBar <= 0 {
+		this.BeatsPerBar = 4
+	}
+	if this.BeatUnit <= 0 {
+		this.BeatUnit = 4
+	}
+	return this
+}
+
+// conductor track data, of tempo, time signature and key signature meta events
+func (this Meta) conductor() []byte {
+	micros := int(math.Round(60000000 / this.BPM))
+	data := []byte{
+		0, 0xFF, 0x51, 3, byte(micros >> 16), byte(micros >> 8), byte(micros),
+		0, 0xFF, 0x58, 4, byte(this.BeatsPerBar), byte(math.Log2(float64(this.BeatUnit))), 24, 8,
+	}
+	if this.Key.Root.IsChromatic() {
+		mode := byte(0)
+		switch this.Key.Mode {
+		case key.Minor, key.HarmonicMinor, key.MelodicMinor:
+			mode = 1
+		}
+		data = append(data, 0, 0xFF, 0x59, 2, byte(int8(this.Key.Signature())), mode)
+	}
+	return append(data, 0, 0xFF, 0x2F, 0)
+}
+
+// performersOf the notes, in the order in which they first appear
+func performersOf(notes []*note.Note) (performers []string) {
+	seen := make(map[string]bool)
+	for _, n := range notes {
+		if !seen[n.Performer] {
+			seen[n.Performer] = true
+			performers = append(performers, n.Performer)
+		}
+	}
+	return
+}
+
+// tickEvent of a note on or note off
+type tickEvent struct {
+	tick  int
+	on    bool
+	bytes []byte
+}
+
+// trackData of the notes of one performer, named for them
+func trackData(performer string, notes []*note.Note, ppq int) (data []byte) {
+	if len(performer) > 0 {
+		data = append(append(append(data, 0, 0xFF, 0x03), varLen(len(performer))...), performer...)
+	}
+	var events []tickEvent
+	for _, n := range notes {
+		number := n.MIDI()
+		if n.Performer != performer || !n.Class.IsChromatic() || number < 0 || number > 127 {
+			continue
+		}
+		channel := byte(n.Channel & 0x0F)
+		velocity := n.Velocity
+		if velocity <= 0 {
+			velocity = DefaultVelocity
+		} else if velocity > 127 {
+			velocity = 127
+		}
+		start := ticksOf(n.Position, ppq)
+		end := ticksOf(n.Position+n.Duration, ppq)
+		if end <= start {
+			end = start + 1
+		}
+		events = append(events,
+			tickEvent{start, true, []byte{0x90 | channel, byte(number), byte(velocity)}},
+			tickEvent{end, false, []byte{0x80 | channel, byte(number), 0}})
+	}
+	// at the same tick, a note ends before another begins
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].tick != events[j].tick {
+			return events[i].tick < events[j].tick
+		}
+		return !events[i].on && events[j].on
+	})
+	tick := 0
+	for _, e := range events {
+		data = append(append(data, varLen(e.tick-tick)...), e.bytes...)
+		tick = e.tick
+	}
+	return append(data, 0, 0xFF, 0x2F, 0)
+}
+
+// ticksOf a position in beats, never before the beginning
+func ticksOf(beats float64, ppq int) int {
+	if beats <= 0 {
+		return 0
+	}
+	return int(math.Round(beats * float64(ppq)))
+}
+
+// varLen quantity, seven bits to a byte, the last without the high bit
+func varLen(value int) []byte {
+	data := []byte{byte(value & 0x7F)}
+	for value >>= 7; value > 0; value >>= 7 {
+		data = append([]byte{byte(value&0x7F | 0x80)}, data...)
+	}
+	return data
+}
+
+func chunkOf(kind string, data []byte) []byte {
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(data)))
+	return append(append([]byte(kind), length...), data...)
+}
+
+func uint16Bytes(values ...uint16) (data []byte) {
+	for _, v := range values {
+		data = append(data, byte(v>>8), byte(v))
+	}
+	return
+}
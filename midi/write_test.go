@@ -0,0 +1,102 @@
+// Writing a Standard MIDI File places each note on and note off at a tick by the PPQ, after a conductor track of tempo, time signature and key signature meta events.
+package midi
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestWrite_ReadBack(t *testing.T) {
+	notes := []*note.Note{
+		{Class: note.C, Octave: 4, Performer: "Piano", Position: 0, Duration: 1, Velocity: 90},
+		{Class: note.E, Octave: 4, Performer: "Piano", Position: 0, Duration: 1, Velocity: 80},
+		{Class: note.C, Octave: 2, Performer: "Bass", Position: 0, Duration: 2, Velocity: 100, Channel: 1},
+		{Class: note.G, Octave: 4, Performer: "Piano", Position: 1, Duration: 0.5, Velocity: 70},
+	}
+	var data bytes.Buffer
+
+	err := Write(&data, notes, Meta{BPM: 100, Key: key.Of("C")})
+
+	assert.Nil(t, err)
+	f, err := Read(&data)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, f.Format)
+	assert.Equal(t, DefaultPPQ, f.PPQ)
+	assert.Equal(t, []Tempo{{Beat: 0, BPM: 100}}, f.Tempos)
+	assert.Equal(t, 3, len(f.Tracks))
+	assert.Equal(t, 0, len(f.Tracks[0].Notes))
+	assert.Equal(t, "Piano", f.Tracks[1].Name)
+	assert.Equal(t, []*note.Note{notes[0], notes[1], notes[3]}, f.Tracks[1].Notes)
+	assert.Equal(t, "Bass", f.Tracks[2].Name)
+	assert.Equal(t, []*note.Note{notes[2]}, f.Tracks[2].Notes)
+}
+
+func TestWrite_RepeatedNote(t *testing.T) {
+	notes := []*note.Note{
+		{Class: note.A, Octave: 4, Position: 0, Duration: 1},
+		{Class: note.A, Octave: 4, Position: 1, Duration: 1},
+	}
+	var data bytes.Buffer
+
+	assert.Nil(t, Write(&data, notes, Meta{}))
+
+	f, err := Read(&data)
+	assert.Nil(t, err)
+	assert.Equal(t, "", f.Tracks[1].Name)
+	assert.Equal(t, 2, len(f.Tracks[1].Notes))
+	for i, n := range f.Tracks[1].Notes {
+		assert.Equal(t, float64(i), n.Position)
+		assert.Equal(t, 1.0, n.Duration)
+		assert.Equal(t, DefaultVelocity, n.Velocity)
+	}
+}
+
+func TestWrite_OmitsMicrotonal(t *testing.T) {
+	notes := []*note.Note{
+		{Class: note.C, Octave: 4, Duration: 1},
+		{Class: note.Nil, Octave: 4, Duration: 1},
+	}
+	var data bytes.Buffer
+
+	assert.Nil(t, Write(&data, notes, Meta{}))
+
+	f, err := Read(&data)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(f.Tracks[1].Notes))
+}
+
+func TestWrite_Conductor(t *testing.T) {
+	var data bytes.Buffer
+
+	assert.Nil(t, Write(&data, nil, Meta{PPQ: 96, BPM: 60, BeatsPerBar: 6, BeatUnit: 8, Key: key.Of("Eb minor")}))
+
+	assert.Equal(t, append(
+		chunkOf("MThd", []byte{0, 1, 0, 1, 0, 96}),
+		chunkOf("MTrk", []byte{
+			0, 0xFF, 0x51, 3, 0x0F, 0x42, 0x40,
+			0, 0xFF, 0x58, 4, 6, 3, 24, 8,
+			0, 0xFF, 0x59, 2, 0xFA, 1,
+			0, 0xFF, 0x2F, 0,
+		})...), data.Bytes())
+}
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "midi")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.mid")
+
+	assert.Nil(t, WriteFile(path, []*note.Note{note.Named("A4")}, Meta{}))
+
+	f, err := ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 69, f.Tracks[1].Notes[0].MIDI())
+}
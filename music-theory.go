@@ -17,6 +17,10 @@
 //	  7: A#
 //	  9: D
 //
+// Write the voiced chord to a MIDI file, for one bar
+//
+//	$ music-theory chord Cmaj9 --midi out.mid
+//
 // List known chord-building rules
 //
 //	$ music-theory chords
@@ -83,6 +87,8 @@
 //	- Natural Minor
 //	- Diminished
 //	- Augmented
+//	- Melodic Minor
+//	- Jazz Minor
 //	- Melodic Minor Ascend
 //	- Melodic Minor Descend
 //	- Harmonic Minor
@@ -93,8 +99,50 @@
 //	- Mixolydian
 //	- Aeolian
 //	- Locrian
+//	- Dorian b2
+//	- Lydian Augmented
+//	- Lydian Dominant
+//	- Mixolydian b6
+//	- 'Locrian #2'
+//	- Altered
+//	- 'Locrian #6'
+//	- 'Ionian #5'
+//	- 'Dorian #4'
+//	- Phrygian Dominant
+//	- 'Lydian #2'
+//	- Ultralocrian
+//	- Harmonic Major
+//	- Dorian b5
+//	- Phrygian b4
+//	- Lydian b3
+//	- Mixolydian b2
+//	- 'Lydian Augmented #2'
+//	- Locrian bb7
+//	- Major Pentatonic
+//	- Minor Pentatonic
+//	- Blues
+//	- Major Blues
+//	- Whole Tone
+//	- Octatonic Whole-Half
+//	- Octatonic Half-Whole
+//	- Bebop Dominant
+//	- Bebop Dorian
+//	- Bebop Major
+//	- Raga Bhimpalasi
+//	- Raga Desh
+//	- Raga Khamaj
+//	- Hungarian Minor
+//	- Hungarian Major
+//	- Neapolitan Minor
+//	- Neapolitan Major
+//	- Double Harmonic
+//	- Hirajoshi
+//	- In
+//	- Iwato
+//	- Kumoi
+//	- Pelog
 //
-// Determine a key
+// Determine a key, its key signature, and its Camelot and Open Key notation
 //
 //	$ music-theory key Db
 //
@@ -103,6 +151,62 @@
 //	relative:
 //	  root: Bb
 //	  mode: Minor
+//	signature:
+//	  sharps: 0
+//	  flats: 5
+//	  accidentals:
+//	  - Bb
+//	  - Eb
+//	  - Ab
+//	  - Db
+//	  - Gb
+//	camelot: 3B
+//	openkey: 8d
+//
+// Build a progression of chords, with bar lines, or with Roman numerals in a key
+//
+//	$ music-theory progression --key C --transpose 2 "| I | vi7 | IV V7 |"
+//
+//	key: D Major
+//	beatsPerBar: 4
+//	beats: 12
+//	chords:
+//	- name: D
+//	  numeral: I
+//	  position: 0
+//	  duration: 4
+//	- name: Bm7
+//	  numeral: vi7
+//	  position: 4
+//	  duration: 4
+//	- name: G
+//	  numeral: IV
+//	  position: 8
+//	  duration: 2
+//	- name: A7
+//	  numeral: V7
+//	  position: 10
+//	  duration: 2
+//
+// Write the voiced chords of a progression to a MIDI file, with the time signature and key signature of the progression
+//
+//	$ music-theory progression --key C --midi out.mid "| I | vi7 | IV V7 |"
+//
+// Calculate the pitch frequency of a note, or the nearest note to a frequency
+//
+//	$ music-theory pitch A4
+//
+//	440.00Hz
+//
+//	$ music-theory pitch 261.6Hz
+//
+//	C4 -0.2 cents
+//
+// Calculate the pitch frequency of a note in another tuning system, tuned from a tonic
+//
+//	$ music-theory --tuning pythagorean --tonic A pitch E5
+//
+//	660.00Hz
 //
 // # Credit
 //
@@ -115,17 +219,24 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"gopkg.in/urfave/cli.v1"
 
 	"github.com/go-music-theory/music-theory/chord"
 	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/midi"
 	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/progression"
 	"github.com/go-music-theory/music-theory/scale"
 )
 
+var errFrequency = errors.New("frequency must be positive")
+
 func main() {
 	app := cli.NewApp()
 	app.EnableBashCompletion = true
@@ -134,10 +245,15 @@ func main() {
 	app.Version = "0.0.4"
 	app.Authors = []cli.Author{cli.Author{Name: "Charney Kaye", Email: "hi@charneykaye.com"}}
 	app.Flags = []cli.Flag{
-		cli.Float64Flag{
+		cli.StringFlag{
 			Name:  "tuning",
-			Value: 440.0,
-			Usage: "tuning frequency for A4 in Hz (e.g., 440 for standard, 432 for Verdi)",
+			Value: "440",
+			Usage: "tuning frequency for A4 in Hz (e.g., 440 for standard, 432 for Verdi), or a tuning system (e.g., just, just 7-limit, pythagorean, meantone, werckmeister, vallotti, 19-EDO)",
+		},
+		cli.StringFlag{
+			Name:  "tonic",
+			Value: "C",
+			Usage: "tonic from which a tuning system is tuned, e.g. D",
 		},
 	}
 	app.Commands = commands
@@ -151,13 +267,35 @@ var commands = []cli.Command{
 		Aliases:     []string{"c"},
 		Usage:       "build a Chord",
 		Description: "Chord is a named harmonic set of three or more pitch classes specified by a name, e.g. C or Cm6 or D♭m679-5",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "midi",
+				Usage: "write the voiced chord, for one bar, to a MIDI file at this path, e.g. out.mid",
+			},
+		},
 		Action: func(c *cli.Context) {
 			name := c.Args().First()
-			if len(name) > 0 {
-				fmt.Printf("%s", chord.Of(name).ToYAML())
-			} else {
+			if len(name) == 0 {
 				// no arguments
 				cli.ShowCommandHelp(c, "chord")
+				return
+			}
+
+			ch := chord.Of(name)
+			fmt.Printf("%s", ch.ToYAML())
+
+			if path := c.String("midi"); len(path) > 0 {
+				notes, err := ch.Voice(chord.Voicing{})
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					return
+				}
+				for _, n := range notes {
+					n.Duration = progression.DefaultBeatsPerBar
+				}
+				if err := midi.WriteFile(path, notes, midi.Meta{}); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				}
 			}
 		},
 	},
@@ -212,11 +350,82 @@ var commands = []cli.Command{
 		},
 	},
 
+	{ // Build a Progression
+		Name:        "progression",
+		Aliases:     []string{"prog"},
+		Usage:       "build a Progression of Chords",
+		Description: "Progression is a succession of chords with bar lines, e.g. \"| C | Am7 | F G7 |\", or Roman numerals in a key, e.g. \"| I | vi7 | IV V7 |\" with --key C",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "key",
+				Usage: "key of the progression, in which chords may be named by Roman numeral, e.g. \"Eb major\"",
+			},
+			cli.IntFlag{
+				Name:  "transpose",
+				Usage: "transpose the progression +/- semitones",
+			},
+			cli.Float64Flag{
+				Name:  "beats",
+				Value: progression.DefaultBeatsPerBar,
+				Usage: "beats per bar",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "output JSON instead of YAML",
+			},
+			cli.StringFlag{
+				Name:  "midi",
+				Usage: "write the voiced chords to a MIDI file at this path, e.g. out.mid",
+			},
+		},
+		Action: func(c *cli.Context) {
+			text := c.Args().First()
+			if len(text) == 0 {
+				// no arguments
+				cli.ShowCommandHelp(c, "progression")
+				return
+			}
+
+			var k key.Key
+			if len(c.String("key")) > 0 {
+				var err error
+				if k, err = key.Parse(c.String("key")); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					return
+				}
+			}
+			p, err := progression.ParseIn(k, text)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+			p = p.WithBeatsPerBar(c.Float64("beats")).Transpose(c.Int("transpose"))
+
+			if c.Bool("json") {
+				fmt.Printf("%s", p.ToJSON())
+			} else {
+				fmt.Printf("%s", p.ToYAML())
+			}
+
+			if path := c.String("midi"); len(path) > 0 {
+				notes, err := p.Notes(chord.Voicing{})
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					return
+				}
+				meta := midi.Meta{BeatsPerBar: int(p.BeatsPerBar), Key: p.Key}
+				if err := midi.WriteFile(path, notes, meta); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				}
+			}
+		},
+	},
+
 	{ // Calculate Pitch
 		Name:        "pitch",
 		Aliases:     []string{"p"},
-		Usage:       "calculate pitch frequency in Hz for a note",
-		Description: "Calculate the pitch frequency in Hz for a note with optional tuning. Supports formats like 'A4' or 'A 4'.",
+		Usage:       "calculate pitch frequency in Hz for a note, or the nearest note for a frequency",
+		Description: "Calculate the pitch frequency in Hz for a note with optional tuning. Supports formats like 'A4' or 'A 4'. Given a frequency like '261.6Hz', find the nearest note and its deviation in cents instead.",
 		Action: func(c *cli.Context) {
 			args := c.Args()
 			if !args.Present() {
@@ -225,8 +434,23 @@ var commands = []cli.Command{
 				return
 			}
 
-			// Get tuning from global flag
-			tuning := note.Tuning(c.GlobalFloat64("tuning"))
+			// Get tuning from global flags
+			tuning, err := tunerOf(c.GlobalString("tuning"), c.GlobalString("tonic"))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+
+			// Reverse mode - find the nearest note to a frequency like "261.6Hz" or "261.6 Hz"
+			if hz, ok := frequencyOf(strings.Join(args, "")); ok {
+				if hz <= 0 {
+					fmt.Fprintln(os.Stderr, errFrequency)
+					return
+				}
+				n, cents := note.FromFrequency(hz, tuning)
+				fmt.Printf("%s %+.1f cents\n", n.String(), cents)
+				return
+			}
 
 			// Parse note - support both "A4" and "A 4" formats
 			var noteName string
@@ -240,10 +464,36 @@ var commands = []cli.Command{
 
 			// Create note and calculate pitch
 			n := note.Named(noteName)
-			frequency := n.Pitch(tuning)
+			frequency := n.PitchIn(tuning)
 
 			// Format output similar to the example in the issue
 			fmt.Printf("%.2fHz\n", frequency)
 		},
 	},
 }
+
+// frequencyOf text in Hz, e.g. "261.6Hz", or false if the text is not a frequency
+func frequencyOf(text string) (float64, bool) {
+	lower := strings.ToLower(strings.TrimSpace(text))
+	if !strings.HasSuffix(lower, "hz") {
+		return 0, false
+	}
+	hz, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(lower, "hz")), 64)
+	return hz, err == nil
+}
+
+// tunerOf a tuning frequency for A4 in Hz, e.g. "432", or else a tuning system by name, e.g. "pythagorean", tuned from the given tonic, returning a *note.ParseError if the tonic is not a note
+func tunerOf(tuning string, tonic string) (note.Tuner, error) {
+	if hz, err := strconv.ParseFloat(tuning, 64); err == nil {
+		return note.Tuning(hz), nil
+	}
+	system, err := note.ParseTuningSystem(tuning)
+	if err != nil {
+		return nil, err
+	}
+	root, err := note.Parse(tonic)
+	if err != nil {
+		return nil, err
+	}
+	return system.WithTonic(root.Class), nil
+}
@@ -2,8 +2,15 @@
 package main
 
 import (
+	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/go-music-theory/music-theory/midi"
+	"github.com/go-music-theory/music-theory/note"
 )
 
 func TestMusicTheory(t *testing.T) {
@@ -25,3 +32,154 @@ func TestHelpCmd(t *testing.T) {
 	}
 	main()
 }
+
+func TestProgressionCmd(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	os.Args = []string{"cmd",
+		"progression", "--key", "C", "--transpose", "2", "| I | vi7 | IV V7 |",
+	}
+	main()
+}
+
+func TestProgressionCmd_InvalidKey(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+	dir, err := ioutil.TempDir("", "music-theory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.mid")
+
+	os.Args = []string{"cmd",
+		"progression", "--key", "C majestic", "--midi", path, "| I | IV V7 |",
+	}
+	main()
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected no MIDI file for an invalid key, got %v", err)
+	}
+}
+
+func TestChordCmd_MIDI(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+	dir, err := ioutil.TempDir("", "music-theory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.mid")
+
+	os.Args = []string{"cmd",
+		"chord", "--midi", path, "Cmaj9",
+	}
+	main()
+
+	f, err := midi.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Tracks) != 2 || len(f.Tracks[1].Notes) != 5 {
+		t.Errorf("expected the 5 notes of Cmaj9, got %v", f.Tracks)
+	}
+}
+
+func TestPitchCmd_Frequency(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	os.Args = []string{"cmd",
+		"pitch", "261.6Hz",
+	}
+	main()
+}
+
+func TestPitchCmd_FrequencyNotPositive(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	for _, frequency := range []string{"0Hz", "-3Hz"} {
+		os.Args = []string{"cmd",
+			"pitch", "--", frequency,
+		}
+		stdout, stderr := captured(t, main)
+		if stdout != "" || !strings.Contains(stderr, errFrequency.Error()) {
+			t.Errorf("pitch %s should be an error, got %q and %q", frequency, stdout, stderr)
+		}
+	}
+}
+
+func TestFrequencyOf(t *testing.T) {
+	for text, expect := range map[string]float64{"261.6Hz": 261.6, "440 hz": 440, " 27.5HZ ": 27.5} {
+		hz, ok := frequencyOf(text)
+		if !ok || hz != expect {
+			t.Errorf("frequencyOf(%q) = %v, %v; want %v", text, hz, ok, expect)
+		}
+	}
+	for _, text := range []string{"A4", "Hz", "C4hz", "440"} {
+		if _, ok := frequencyOf(text); ok {
+			t.Errorf("frequencyOf(%q) should not be a frequency", text)
+		}
+	}
+}
+
+func TestPitchCmd_TuningSystem(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	os.Args = []string{"cmd",
+		"--tuning", "werckmeister", "--tonic", "D", "pitch", "C5",
+	}
+	main()
+}
+
+func TestTunerOf(t *testing.T) {
+	tuner, err := tunerOf("432", "C")
+	if err != nil || tuner != note.TuningVerdi {
+		t.Errorf("tunerOf(432) = %v, %v", tuner, err)
+	}
+	tuner, err = tunerOf("pythagorean", "A")
+	if err != nil || math.Abs(tuner.Frequency(note.Named("E5"))-660) > 0.0000001 {
+		t.Errorf("tunerOf(pythagorean, A) = %v, %v", tuner, err)
+	}
+	if _, err = tunerOf("kirnberger", "C"); err != note.ErrTuningSystem {
+		t.Errorf("tunerOf(kirnberger) should be %v, got %v", note.ErrTuningSystem, err)
+	}
+	for _, tonic := range []string{"H", "Cfoo", ""} {
+		if _, err = tunerOf("pythagorean", tonic); err == nil {
+			t.Errorf("tunerOf(pythagorean, %q) should be an error", tonic)
+		}
+	}
+}
+
+//
+// Private
+//
+
+// captured output of a function to stdout and stderr
+func captured(t *testing.T, f func()) (stdout string, stderr string) {
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	defer func() { os.Stdout, os.Stderr = oldStdout, oldStderr }()
+	outFile, err := ioutil.TempFile("", "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(outFile.Name())
+	errFile, err := ioutil.TempFile("", "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(errFile.Name())
+
+	os.Stdout, os.Stderr = outFile, errFile
+	f()
+	outFile.Close()
+	errFile.Close()
+
+	out, _ := ioutil.ReadFile(outFile.Name())
+	errOut, _ := ioutil.ReadFile(errFile.Name())
+	return string(out), string(errOut)
+}
@@ -55,3 +55,31 @@ func ExampleClass_String() {
 	// C#
 	// Db
 }
+
+// ExampleParseInterval demonstrates interval arithmetic with spelled pitch classes
+func ExampleParseInterval() {
+	third, _ := note.ParseInterval("M3")
+	fmt.Println(third, third.Semitones(), third.Invert())
+
+	fmt.Println(note.SpelledNamed("E#").Add(third))
+	fmt.Println(note.IntervalBetween(note.SpelledNamed("F"), note.SpelledNamed("B")))
+
+	// Output:
+	// M3 4 m6
+	// Gx
+	// A4
+}
+
+// ExampleTuningSystem demonstrates the pitch of notes in tuning systems other than equal temperament
+func ExampleTuningSystem() {
+	just := note.JustIntonation5Limit.WithTonic(note.A)
+	for _, name := range []string{"A4", "C#5", "E5"} {
+		n := note.Named(name)
+		fmt.Printf("%s: %.2fHz equal, %.2fHz just\n", name, n.Pitch(note.TuningStandard), n.PitchIn(just))
+	}
+
+	// Output:
+	// A4: 440.00Hz equal, 440.00Hz just
+	// C#5: 554.37Hz equal, 550.00Hz just
+	// E5: 659.26Hz equal, 660.00Hz just
+}
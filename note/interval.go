@@ -0,0 +1,250 @@
+// An interval is the difference in pitch between two sounds, named by its quality and generic number, e.g. a major third (M3) or a perfect fifth (P5).
+package note
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Quality of an Interval, e.g. Perfect, Major or Diminished
+type Quality int
+
+const (
+	NilQuality Quality = iota
+	Perfect
+	Major
+	Minor
+	Augmented
+	Diminished
+	DoublyAugmented
+	DoublyDiminished
+)
+
+// String of the Quality, e.g. "P" or "M" or "d"
+func (q Quality) String() string {
+	switch q {
+	case Perfect:
+		return "P"
+	case Major:
+		return "M"
+	case Minor:
+		return "m"
+	case Augmented:
+		return "A"
+	case Diminished:
+		return "d"
+	case DoublyAugmented:
+		return "AA"
+	case DoublyDiminished:
+		return "dd"
+	}
+	return ""
+}
+
+// Interval between two notes, with a Quality and a generic Number counted in letter names from 1 (unison) to 8 (octave) and beyond for compound intervals, e.g. 10 (a tenth)
+type Interval struct {
+	Quality Quality
+	Number  int
+}
+
+// ParseInterval from its name, e.g. "M3", "P5", "d7", "A4" or "m10", returning a *ParseError if the quality or number is not understood
+func ParseInterval(text string) (Interval, error) {
+	m := rgxInterval.FindStringSubmatch(text)
+	if m == nil {
+		if q := rgxIntervalQuality.FindString(text); len(q) > 0 {
+			return Interval{}, &ParseError{Name: text, Part: NumberPart, Offset: len(q)}
+		}
+		return Interval{}, &ParseError{Name: text, Part: QualityPart, Offset: 0}
+	}
+	number, _ := strconv.Atoi(m[2])
+	if number < 1 {
+		return Interval{}, &ParseError{Name: text, Part: NumberPart, Offset: len(m[1])}
+	}
+	i := Interval{Quality: qualityOf(m[1]), Number: number}
+	if _, ok := i.adjustment(); !ok {
+		return Interval{}, &ParseError{Name: text, Part: QualityPart, Offset: 0}
+	}
+	return i, nil
+}
+
+// IntervalBetween two spelled pitch classes, ascending from one to the other within an octave, e.g. from E to C is a minor sixth
+func IntervalBetween(from Spelled, to Spelled) Interval {
+	if from.Letter == NilLetter || to.Letter == NilLetter {
+		return Interval{}
+	}
+	steps := ((int(to.Letter)-int(from.Letter))%7 + 7) % 7
+	natural := ((classToSemitone(to.Letter.natural())-classToSemitone(from.Letter.natural()))%12 + 12) % 12
+	return intervalOf(steps+1, natural+to.Accidental-from.Accidental)
+}
+
+// String of the Interval, e.g. "M3"
+func (i Interval) String() string {
+	if i.Quality == NilQuality || i.Number < 1 {
+		return "-"
+	}
+	return i.Quality.String() + strconv.Itoa(i.Number)
+}
+
+// Semitones spanned by the Interval, e.g. 4 for a major third or 16 for a major tenth
+func (i Interval) Semitones() int {
+	adjust, _ := i.adjustment()
+	return majorOrPerfectSemitones[i.simpleNumber()-1] + 12*i.Octaves() + adjust
+}
+
+// Octaves by which a compound Interval exceeds its simple Interval, e.g. 1 for a major tenth
+func (i Interval) Octaves() int {
+	if i.Number < 1 {
+		return 0
+	}
+	return (i.Number - 1) / 7
+}
+
+// Simple Interval within an octave, e.g. a major third for a major tenth. The octave itself remains an octave.
+func (i Interval) Simple() Interval {
+	if i.Number == 8 {
+		return i
+	}
+	return Interval{Quality: i.Quality, Number: i.simpleNumber()}
+}
+
+// Invert the Interval, e.g. a major third becomes a minor sixth. Compound intervals are inverted as their simple interval.
+func (i Interval) Invert() Interval {
+	number := 9 - i.simpleNumber()
+	if i.Number == 8 {
+		number = 1
+	}
+	return Interval{Quality: invertedQuality[i.Quality], Number: number}
+}
+
+// Compare to another Interval by semitones, and then by generic number, returning -1, 0 or +1
+func (i Interval) Compare(other Interval) int {
+	switch {
+	case i.Semitones() < other.Semitones():
+		return -1
+	case i.Semitones() > other.Semitones():
+		return 1
+	case i.Number < other.Number:
+		return -1
+	case i.Number > other.Number:
+		return 1
+	}
+	return 0
+}
+
+// Add an Interval to the spelled pitch class, ascending, e.g. E# plus a major third is Gx
+func (s Spelled) Add(i Interval) Spelled {
+	if s.Letter == NilLetter || i.Number < 1 {
+		return Spelled{}
+	}
+	letter := s.Letter.Step(i.Number - 1)
+	return Spelled{
+		Letter:     letter,
+		Accidental: nearestAccidental(classToSemitone(s.Letter.natural()) + s.Accidental + i.Semitones() - classToSemitone(letter.natural())),
+	}
+}
+
+//
+// Private
+//
+
+var (
+	rgxInterval, _        = regexp.Compile("^(AA|dd|P|M|m|A|d)([0-9]+)$")
+	rgxIntervalQuality, _ = regexp.Compile("^(AA|dd|P|M|m|A|d)")
+)
+
+// Semitones of the major or perfect simple interval for each generic number 1-7
+var majorOrPerfectSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+
+// Inverse of each Quality, e.g. Major inverts to Minor
+var invertedQuality = map[Quality]Quality{
+	NilQuality:       NilQuality,
+	Perfect:          Perfect,
+	Major:            Minor,
+	Minor:            Major,
+	Augmented:        Diminished,
+	Diminished:       Augmented,
+	DoublyAugmented:  DoublyDiminished,
+	DoublyDiminished: DoublyAugmented,
+}
+
+func qualityOf(text string) Quality {
+	switch text {
+	case "P":
+		return Perfect
+	case "M":
+		return Major
+	case "m":
+		return Minor
+	case "A":
+		return Augmented
+	case "d":
+		return Diminished
+	case "AA":
+		return DoublyAugmented
+	case "dd":
+		return DoublyDiminished
+	}
+	return NilQuality
+}
+
+// simpleNumber of the Interval, from 1 (unison) to 7 (seventh)
+func (i Interval) simpleNumber() int {
+	if i.Number < 1 {
+		return 1
+	}
+	return (i.Number-1)%7 + 1
+}
+
+// isPerfectNumber is true for unisons, fourths, fifths and octaves (and their compounds)
+func (i Interval) isPerfectNumber() bool {
+	switch i.simpleNumber() {
+	case 1, 4, 5:
+		return true
+	}
+	return false
+}
+
+// adjustment in semitones from the major or perfect interval of the same number, and whether the Quality is valid for the number
+func (i Interval) adjustment() (int, bool) {
+	if i.isPerfectNumber() {
+		switch i.Quality {
+		case Perfect:
+			return 0, true
+		case Augmented:
+			return 1, true
+		case DoublyAugmented:
+			return 2, true
+		case Diminished:
+			return -1, true
+		case DoublyDiminished:
+			return -2, true
+		}
+		return 0, false
+	}
+	switch i.Quality {
+	case Major:
+		return 0, true
+	case Minor:
+		return -1, true
+	case Augmented:
+		return 1, true
+	case DoublyAugmented:
+		return 2, true
+	case Diminished:
+		return -2, true
+	case DoublyDiminished:
+		return -3, true
+	}
+	return 0, false
+}
+
+// intervalOf the generic number spanning the given semitones, or an Interval with NilQuality if no quality fits
+func intervalOf(number int, semitones int) Interval {
+	for q := Perfect; q <= DoublyDiminished; q++ {
+		i := Interval{Quality: q, Number: number}
+		if _, ok := i.adjustment(); ok && i.Semitones() == semitones {
+			return i
+		}
+	}
+	return Interval{Number: number}
+}
@@ -0,0 +1,117 @@
+// An interval is the difference in pitch between two sounds, named by its quality and generic number, e.g. a major third (M3) or a perfect fifth (P5).
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParseInterval(t *testing.T) {
+	assertParseInterval(t, "M3", Major, 3, 4)
+	assertParseInterval(t, "m3", Minor, 3, 3)
+	assertParseInterval(t, "P5", Perfect, 5, 7)
+	assertParseInterval(t, "d7", Diminished, 7, 9)
+	assertParseInterval(t, "A4", Augmented, 4, 6)
+	assertParseInterval(t, "d5", Diminished, 5, 6)
+	assertParseInterval(t, "P1", Perfect, 1, 0)
+	assertParseInterval(t, "P8", Perfect, 8, 12)
+	assertParseInterval(t, "M9", Major, 9, 14)
+	assertParseInterval(t, "m10", Minor, 10, 15)
+	assertParseInterval(t, "P12", Perfect, 12, 19)
+	assertParseInterval(t, "AA4", DoublyAugmented, 4, 7)
+	assertParseInterval(t, "dd7", DoublyDiminished, 7, 8)
+}
+
+func TestParseInterval_Invalid(t *testing.T) {
+	assertParseIntervalError(t, "", QualityPart, 0)
+	assertParseIntervalError(t, "X3", QualityPart, 0)
+	assertParseIntervalError(t, "P3", QualityPart, 0)
+	assertParseIntervalError(t, "M5", QualityPart, 0)
+	assertParseIntervalError(t, "M", NumberPart, 1)
+	assertParseIntervalError(t, "M0", NumberPart, 1)
+	assertParseIntervalError(t, "Mx", NumberPart, 1)
+}
+
+func TestIntervalBetween(t *testing.T) {
+	assert.Equal(t, "M3", IntervalBetween(SpelledNamed("C"), SpelledNamed("E")).String())
+	assert.Equal(t, "m6", IntervalBetween(SpelledNamed("E"), SpelledNamed("C")).String())
+	assert.Equal(t, "A4", IntervalBetween(SpelledNamed("F"), SpelledNamed("B")).String())
+	assert.Equal(t, "d5", IntervalBetween(SpelledNamed("B"), SpelledNamed("F")).String())
+	assert.Equal(t, "d7", IntervalBetween(SpelledNamed("C#"), SpelledNamed("Bb")).String())
+	assert.Equal(t, "A6", IntervalBetween(SpelledNamed("C"), SpelledNamed("A#")).String())
+	assert.Equal(t, "m2", IntervalBetween(SpelledNamed("B"), SpelledNamed("C")).String())
+	assert.Equal(t, "d2", IntervalBetween(SpelledNamed("B#"), SpelledNamed("C")).String())
+	assert.Equal(t, "A1", IntervalBetween(SpelledNamed("C"), SpelledNamed("C#")).String())
+	assert.Equal(t, "P1", IntervalBetween(SpelledNamed("Eb"), SpelledNamed("Eb")).String())
+	assert.Equal(t, Interval{}, IntervalBetween(Spelled{}, SpelledNamed("C")))
+	assert.Equal(t, Interval{Number: 3}, IntervalBetween(SpelledNamed("C"), SpelledNamed("Ebbbb")))
+}
+
+func TestInterval_String(t *testing.T) {
+	assert.Equal(t, "M3", Interval{Major, 3}.String())
+	assert.Equal(t, "AA4", Interval{DoublyAugmented, 4}.String())
+	assert.Equal(t, "-", Interval{}.String())
+	assert.Equal(t, "-", Interval{Number: 3}.String())
+}
+
+func TestInterval_OctavesAndSimple(t *testing.T) {
+	assert.Equal(t, 0, Interval{Major, 3}.Octaves())
+	assert.Equal(t, 1, Interval{Major, 10}.Octaves())
+	assert.Equal(t, 1, Interval{Perfect, 8}.Octaves())
+	assert.Equal(t, 0, Interval{}.Octaves())
+	assert.Equal(t, Interval{Major, 3}, Interval{Major, 10}.Simple())
+	assert.Equal(t, Interval{Perfect, 8}, Interval{Perfect, 8}.Simple())
+	assert.Equal(t, Interval{Perfect, 1}, Interval{Perfect, 15}.Simple())
+}
+
+func TestInterval_Invert(t *testing.T) {
+	assert.Equal(t, Interval{Minor, 6}, Interval{Major, 3}.Invert())
+	assert.Equal(t, Interval{Perfect, 4}, Interval{Perfect, 5}.Invert())
+	assert.Equal(t, Interval{Diminished, 5}, Interval{Augmented, 4}.Invert())
+	assert.Equal(t, Interval{Augmented, 2}, Interval{Diminished, 7}.Invert())
+	assert.Equal(t, Interval{Perfect, 1}, Interval{Perfect, 8}.Invert())
+	assert.Equal(t, Interval{Perfect, 8}, Interval{Perfect, 1}.Invert())
+	assert.Equal(t, Interval{Minor, 7}, Interval{Major, 9}.Invert())
+}
+
+func TestInterval_Compare(t *testing.T) {
+	assert.Equal(t, -1, Interval{Minor, 3}.Compare(Interval{Major, 3}))
+	assert.Equal(t, 1, Interval{Perfect, 5}.Compare(Interval{Augmented, 4}))
+	assert.Equal(t, -1, Interval{Augmented, 4}.Compare(Interval{Diminished, 5}))
+	assert.Equal(t, 1, Interval{Diminished, 5}.Compare(Interval{Augmented, 4}))
+	assert.Equal(t, 0, Interval{Major, 3}.Compare(Interval{Major, 3}))
+}
+
+func TestSpelled_Add(t *testing.T) {
+	assert.Equal(t, "E", SpelledNamed("C").Add(Interval{Major, 3}).String())
+	assert.Equal(t, "Gx", SpelledNamed("E#").Add(Interval{Major, 3}).String())
+	assert.Equal(t, "Bb", SpelledNamed("C#").Add(Interval{Diminished, 7}).String())
+	assert.Equal(t, "Db", SpelledNamed("C").Add(Interval{Minor, 9}).String())
+	assert.Equal(t, "F", SpelledNamed("B").Add(Interval{Diminished, 5}).String())
+	assert.Equal(t, "C", SpelledNamed("C").Add(Interval{Perfect, 8}).String())
+	assert.Equal(t, Spelled{}, Spelled{}.Add(Interval{Major, 3}))
+}
+
+func TestQualityString(t *testing.T) {
+	assert.Equal(t, "P", Perfect.String())
+	assert.Equal(t, "dd", DoublyDiminished.String())
+	assert.Equal(t, "", NilQuality.String())
+}
+
+//
+// Private
+//
+
+func assertParseInterval(t *testing.T, text string, expectQuality Quality, expectNumber int, expectSemitones int) {
+	i, err := ParseInterval(text)
+	assert.Nil(t, err, text)
+	assert.Equal(t, Interval{Quality: expectQuality, Number: expectNumber}, i, text)
+	assert.Equal(t, expectSemitones, i.Semitones(), text)
+	assert.Equal(t, text, i.String())
+}
+
+func assertParseIntervalError(t *testing.T, text string, expectPart NamePart, expectOffset int) {
+	_, err := ParseInterval(text)
+	assert.Equal(t, &ParseError{Name: text, Part: expectPart, Offset: expectOffset}, err, text)
+}
@@ -12,14 +12,21 @@
 // https://xj.io
 package note
 
+import (
+	"strconv"
+)
+
 // Note models a musical note
 type Note struct {
-	Class  Class  // Class of pitch
-	Octave Octave // Octave #
+	Class     Class     // Class of pitch
+	Octave    Octave    // Octave #
+	AdjSymbol AdjSymbol // Can be used to spell the Class with Sharps or Flats
 
 	Performer string  // Can be used to sort out whose Notes are whose
 	Position  float64 // Can be used to represent time within the composition
 	Duration  float64 // Can be used to represent time of note duration
+	Velocity  int     // Can be used to represent loudness, e.g. MIDI velocity from 1 to 127
+	Channel   int     // Can be used to represent the instrument channel, e.g. MIDI channel from 0 to 15
 	Code      string  // Can be used to store any custom values
 }
 
@@ -36,6 +43,15 @@ func Named(text string) (n *Note) {
 	return
 }
 
+// String of the Note as its Class and Octave, e.g. "C#4", spelled with its AdjSymbol, or else with Sharps
+func (n *Note) String() string {
+	with := n.AdjSymbol
+	if with == No {
+		with = Sharp
+	}
+	return n.Class.String(with) + strconv.Itoa(int(n.Octave))
+}
+
 // OfClass pitch returns a Note model
 func OfClass(class Class) (n *Note) {
 	n = &Note{}
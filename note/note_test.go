@@ -25,3 +25,10 @@ func TestClassNamed(t *testing.T) {
 	n := ClassNamed("C")
 	assert.Equal(t, n, C)
 }
+
+func TestNoteString(t *testing.T) {
+	assert.Equal(t, "C4", Named("C4").String())
+	assert.Equal(t, "F#3", Named("Gb3").String())
+	assert.Equal(t, "Gb3", (&Note{Class: Fs, Octave: 3, AdjSymbol: Flat}).String())
+	assert.Equal(t, "A-1", (&Note{Class: A, Octave: -1}).String())
+}
@@ -0,0 +1,116 @@
+// Names (e.g. of a note, chord, scale or key) can be parsed strictly, reporting which part of the name could not be understood.
+package note
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// NamePart is a part of a name (e.g. of a note, chord, scale or key) which may fail to parse
+type NamePart int
+
+const (
+	RootPart NamePart = iota
+	AccidentalPart
+	OctavePart
+	FormPart
+	ModePart
+	BassPart
+	QualityPart
+	NumberPart
+	NumeralPart
+	FigurePart
+)
+
+// String of the NamePart, e.g. "root" or "accidental"
+func (of NamePart) String() string {
+	switch of {
+	case RootPart:
+		return "root"
+	case AccidentalPart:
+		return "accidental"
+	case OctavePart:
+		return "octave"
+	case FormPart:
+		return "form"
+	case ModePart:
+		return "mode"
+	case BassPart:
+		return "bass"
+	case QualityPart:
+		return "quality"
+	case NumberPart:
+		return "number"
+	case NumeralPart:
+		return "numeral"
+	case FigurePart:
+		return "figure"
+	}
+	return ""
+}
+
+// ParseError reports which part of a name could not be parsed, and at what byte offset
+type ParseError struct {
+	Name   string   // Full name that was being parsed
+	Part   NamePart // Part of the name that could not be parsed
+	Offset int      // Byte offset within Name where the unparseable part begins
+}
+
+// Error describes the part of the name that could not be parsed
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cannot parse %s at offset %d of %q", e.Part, e.Offset, e.Name)
+}
+
+// Within a longer name beginning at the given byte offset, e.g. the bass note of a slash chord
+func (e *ParseError) Within(name string, offset int) *ParseError {
+	return &ParseError{
+		Name:   name,
+		Part:   e.Part,
+		Offset: e.Offset + offset,
+	}
+}
+
+// Parse a note strictly, e.g. Parse("C#4"), returning a *ParseError if any part of the name is not understood
+func Parse(text string) (*Note, error) {
+	_, end, err := ParseRoot(text)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := strings.TrimRightFunc(text[end:], unicode.IsSpace)
+	if len(rest) > 0 && !rgxOctaveOnly.MatchString(rest) {
+		if AdjSymbolBegin(rest) != No {
+			return nil, &ParseError{Name: text, Part: AccidentalPart, Offset: end}
+		}
+		return nil, &ParseError{Name: text, Part: OctavePart, Offset: end}
+	}
+
+	return Named(text), nil
+}
+
+// ParseRoot parses the root Class that begins a name (e.g. of a chord, scale or key), returning the byte offset at which the remaining name begins, or a *ParseError if the name does not begin with a note.
+func ParseRoot(name string) (Class, int, error) {
+	r := rgxDouble.FindString(name)
+	if len(r) > 0 {
+		if AdjSymbolBegin(name[len(r):]) != No && !rgxFlatSuffix.MatchString(name[len(r):]) {
+			// a second accidental, e.g. C#♯ or D♭♭
+			return Nil, 0, &ParseError{Name: name, Part: AccidentalPart, Offset: len(r)}
+		}
+	} else if r = rgxSingle.FindString(name); len(r) == 0 {
+		return Nil, 0, &ParseError{Name: name, Part: RootPart, Offset: 0}
+	}
+
+	rest := name[len(r):]
+	return ClassNamed(r), len(name) - len(strings.TrimLeftFunc(rest, unicode.IsSpace)), nil
+}
+
+//
+// Private
+//
+
+var (
+	rgxOctaveOnly, _ = regexp.Compile("^[ ]*-?[0-9]+$")
+	rgxFlatSuffix, _ = regexp.Compile("^b")
+)
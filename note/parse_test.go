@@ -0,0 +1,75 @@
+// Names (e.g. of a note, chord, scale or key) can be parsed strictly, reporting which part of the name could not be understood.
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestParse(t *testing.T) {
+	n, err := Parse("C#4")
+	assert.Nil(t, err)
+	assert.Equal(t, &Note{Class: Cs, Octave: 4}, n)
+
+	n, err = Parse("Bb")
+	assert.Nil(t, err)
+	assert.Equal(t, &Note{Class: As}, n)
+
+	n, err = Parse("A -1")
+	assert.Nil(t, err)
+	assert.Equal(t, &Note{Class: A, Octave: -1}, n)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	assertParseError(t, "", RootPart, 0)
+	assertParseError(t, "H4", RootPart, 0)
+	assertParseError(t, "C#♯4", AccidentalPart, 2)
+	assertParseError(t, "Dbb", AccidentalPart, 2)
+	assertParseError(t, "C4z", OctavePart, 1)
+	assertParseError(t, "E major", OctavePart, 2)
+}
+
+func TestParseRoot(t *testing.T) {
+	root, offset, err := ParseRoot("B♭ min")
+	assert.Nil(t, err)
+	assert.Equal(t, As, root)
+	assert.Equal(t, 5, offset)
+
+	root, offset, err = ParseRoot("C#b5")
+	assert.Nil(t, err)
+	assert.Equal(t, Cs, root)
+	assert.Equal(t, 2, offset)
+
+	_, _, err = ParseRoot("JAMS")
+	assert.Equal(t, &ParseError{Name: "JAMS", Part: RootPart, Offset: 0}, err)
+}
+
+func TestParseError(t *testing.T) {
+	err := &ParseError{Name: "X", Part: RootPart, Offset: 0}
+	assert.Equal(t, `cannot parse root at offset 0 of "X"`, err.Error())
+	assert.Equal(t, &ParseError{Name: "C/X", Part: RootPart, Offset: 2}, err.Within("C/X", 2))
+}
+
+func TestNamePartString(t *testing.T) {
+	assert.Equal(t, "root", RootPart.String())
+	assert.Equal(t, "accidental", AccidentalPart.String())
+	assert.Equal(t, "octave", OctavePart.String())
+	assert.Equal(t, "form", FormPart.String())
+	assert.Equal(t, "mode", ModePart.String())
+	assert.Equal(t, "bass", BassPart.String())
+	assert.Equal(t, "quality", QualityPart.String())
+	assert.Equal(t, "number", NumberPart.String())
+	assert.Equal(t, "numeral", NumeralPart.String())
+	assert.Equal(t, "figure", FigurePart.String())
+	assert.Equal(t, "", NamePart(99).String())
+}
+
+//
+// Private
+//
+
+func assertParseError(t *testing.T, name string, expectPart NamePart, expectOffset int) {
+	_, err := Parse(name)
+	assert.Equal(t, &ParseError{Name: name, Part: expectPart, Offset: expectOffset}, err, name)
+}
@@ -15,10 +15,29 @@ const (
 	TuningVerdi Tuning = 432.0
 )
 
+// Tuner gives the frequency in Hz of any Note, e.g. a Tuning of A4 in 12-tone equal temperament, or a TuningSystem
+type Tuner interface {
+	Frequency(n *Note) float64
+}
+
 // Pitch returns the frequency in Hz for this note based on the given tuning.
 // Uses the standard pitch formula: f(n) = tuning * 2^((n-69)/12)
 // where n is the MIDI note number and 69 is the MIDI number for A4.
 func (n *Note) Pitch(tuning Tuning) float64 {
+	return tuning.Frequency(n)
+}
+
+// PitchIn returns the frequency in Hz for this note in the given tuning system, e.g. Pythagorean, or any Tuner.
+// Uses TuningStandard if the system is nil.
+func (n *Note) PitchIn(system Tuner) float64 {
+	if system == nil {
+		system = TuningStandard
+	}
+	return system.Frequency(n)
+}
+
+// Frequency in Hz of the Note in 12-tone equal temperament, with this Tuning of A4.
+func (tuning Tuning) Frequency(n *Note) float64 {
 	if tuning == 0 {
 		tuning = TuningStandard
 	}
@@ -36,6 +55,36 @@ func (n *Note) MIDI() int {
 	return (int(n.Octave)+1)*12 + semitoneOffset
 }
 
+// FromMIDI note number returns a Note, spelled with Sharps or Flats, e.g. FromMIDI(61, Flat) is Db4.
+// MIDI note numbers: C-1 = 0, A4 = 69, C4 (middle C) = 60
+func FromMIDI(n int, spelling AdjSymbol) *Note {
+	class, octave := C.Step(n - 60)
+	return &Note{Class: class, Octave: 4 + octave, AdjSymbol: spelling}
+}
+
+// FromFrequency in Hz returns the nearest Note based on the given tuning, plus its deviation in cents (hundredths of a semitone), e.g. 445Hz is A4 +19.6 cents.
+// Uses TuningStandard if the tuning is nil. Returns a Note of Nil class for a frequency that is not positive.
+func FromFrequency(hz float64, tuning Tuner) (*Note, float64) {
+	if tuning == nil {
+		tuning = TuningStandard
+	}
+	if hz <= 0 {
+		return &Note{}, 0
+	}
+	// of the notes nearest in equal temperament, the one nearest in the given tuning
+	estimate := int(math.Round(69 + 12*math.Log2(hz/tuning.Frequency(&Note{Class: A, Octave: 4}))))
+	var nearest *Note
+	var cents float64
+	for m := estimate - 1; m <= estimate+1; m++ {
+		n := FromMIDI(m, Sharp)
+		c := 1200 * math.Log2(hz/tuning.Frequency(n))
+		if nearest == nil || math.Abs(c) < math.Abs(cents) {
+			nearest, cents = n, c
+		}
+	}
+	return nearest, cents
+}
+
 // classToSemitone returns the semitone offset from C for a given pitch class
 func classToSemitone(c Class) int {
 	switch c {
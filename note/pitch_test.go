@@ -180,3 +180,55 @@ func TestNamedWithPitch(t *testing.T) {
 		t.Errorf("Named('C4').Pitch() = %v, want 261.6255653005986", pitch2)
 	}
 }
+
+func TestFromMIDI(t *testing.T) {
+	assert.Equal(t, &Note{Class: C, Octave: 4, AdjSymbol: Sharp}, FromMIDI(60, Sharp))
+	assert.Equal(t, &Note{Class: A, Octave: 4, AdjSymbol: Sharp}, FromMIDI(69, Sharp))
+	assert.Equal(t, &Note{Class: C, Octave: -1, AdjSymbol: Sharp}, FromMIDI(0, Sharp))
+	assert.Equal(t, &Note{Class: G, Octave: 9, AdjSymbol: Sharp}, FromMIDI(127, Sharp))
+	assert.Equal(t, &Note{Class: B, Octave: 3, AdjSymbol: Flat}, FromMIDI(59, Flat))
+	assert.Equal(t, "Db4", FromMIDI(61, Flat).String())
+	assert.Equal(t, "C#4", FromMIDI(61, Sharp).String())
+	for n := 0; n < 128; n++ {
+		assert.Equal(t, n, FromMIDI(n, Sharp).MIDI())
+	}
+}
+
+func TestFromFrequency(t *testing.T) {
+	tests := []struct {
+		hz       float64
+		tuning   Tuning
+		expected string
+		cents    float64
+	}{
+		{440, TuningStandard, "A4", 0},
+		{261.6255653005986, TuningStandard, "C4", 0},
+		{261.6, TuningStandard, "C4", -0.17},
+		{445, TuningStandard, "A4", 19.56},
+		{452, TuningStandard, "A4", 46.58},
+		{454, TuningStandard, "A#4", -45.77},
+		{432, TuningVerdi, "A4", 0},
+		{440, TuningVerdi, "A4", 31.77},
+		{27.5, 0, "A0", 0},
+		{8.175798915643707, TuningStandard, "C-1", 0},
+	}
+	for _, tt := range tests {
+		n, cents := FromFrequency(tt.hz, tt.tuning)
+		assert.Equal(t, tt.expected, n.String())
+		assert.InDelta(t, tt.cents, cents, 0.01)
+	}
+}
+
+func TestFromFrequency_NotPositive(t *testing.T) {
+	n, cents := FromFrequency(0, TuningStandard)
+	assert.Equal(t, Nil, n.Class)
+	assert.Equal(t, 0.0, cents)
+}
+
+func TestFromFrequency_Pitch(t *testing.T) {
+	for m := 0; m < 128; m++ {
+		n, cents := FromFrequency(FromMIDI(m, Sharp).Pitch(TuningStandard), TuningStandard)
+		assert.Equal(t, m, n.MIDI())
+		assert.InDelta(t, 0, cents, 0.000001)
+	}
+}
@@ -0,0 +1,180 @@
+// A spelled pitch class has a letter name and an accidental, distinguishing enharmonic equivalents such as C# and Db, or E# and F.
+package note
+
+import (
+	"strings"
+)
+
+// Letter name of a note, without any accidental
+type Letter int
+
+const (
+	NilLetter Letter = iota
+	CLetter
+	DLetter
+	ELetter
+	FLetter
+	GLetter
+	ALetter
+	BLetter
+)
+
+// String of the Letter, e.g. "C"
+func (l Letter) String() string {
+	switch l {
+	case CLetter:
+		return "C"
+	case DLetter:
+		return "D"
+	case ELetter:
+		return "E"
+	case FLetter:
+		return "F"
+	case GLetter:
+		return "G"
+	case ALetter:
+		return "A"
+	case BLetter:
+		return "B"
+	}
+	return "-"
+}
+
+// Step from a letter to another letter, +/- letter names, e.g. CLetter.Step(2) is ELetter
+func (l Letter) Step(inc int) Letter {
+	if l == NilLetter {
+		return NilLetter
+	}
+	return Letter(((int(l)-1+inc)%7+7)%7 + 1)
+}
+
+// Spelled pitch class, with a Letter name and a number of sharps (positive) or flats (negative), e.g. E# or Bbb
+type Spelled struct {
+	Letter     Letter
+	Accidental int
+}
+
+// SpelledNamed returns a Spelled pitch class, e.g. SpelledNamed("Fx") or SpelledNamed("Bbb")
+func SpelledNamed(text string) (s Spelled) {
+	s.Letter = letterOf(text)
+	if s.Letter == NilLetter {
+		return
+	}
+
+	for _, r := range text[1:] {
+		switch r {
+		case '#', '♯':
+			s.Accidental++
+		case 'x', '𝄪':
+			s.Accidental += 2
+		case 'b', '♭':
+			s.Accidental--
+		case '𝄫':
+			s.Accidental -= 2
+		default:
+			return
+		}
+	}
+	return
+}
+
+// Class of the spelled pitch, collapsing enharmonic equivalents, e.g. E# is F
+func (s Spelled) Class() Class {
+	if s.Letter == NilLetter {
+		return Nil
+	}
+	c, _ := s.Letter.natural().Step(s.Accidental)
+	return c
+}
+
+// String of the spelled pitch, e.g. "E#", "Fx" or "Bbb"
+func (s Spelled) String() string {
+	if s.Letter == NilLetter {
+		return "-"
+	}
+	if s.Accidental < 0 {
+		return s.Letter.String() + strings.Repeat("b", -s.Accidental)
+	}
+	return s.Letter.String() + strings.Repeat("#", s.Accidental%2) + strings.Repeat("x", s.Accidental/2)
+}
+
+// Spelled pitch of the Class, with its default spelling using Sharps or Flats
+func (from Class) Spelled(with AdjSymbol) Spelled {
+	switch from {
+	case C, D, E, F, G, A, B:
+		return from.SpelledAs(letterOf(from.String(No)))
+	}
+	if !from.IsChromatic() {
+		return Spelled{}
+	}
+	if with == Flat {
+		return from.SpelledAs(letterOf(stringFlatOf(from)))
+	}
+	return from.SpelledAs(letterOf(stringSharpOf(from)))
+}
+
+// SpelledAs the given Letter, e.g. F spelled as E is E#, and G spelled as A is Abb
+func (from Class) SpelledAs(letter Letter) Spelled {
+	if letter == NilLetter || !from.IsChromatic() {
+		return Spelled{}
+	}
+	return Spelled{
+		Letter:     letter,
+		Accidental: nearestAccidental(classToSemitone(from) - classToSemitone(letter.natural())),
+	}
+}
+
+// IsChromatic is true for the twelve pitch classes of the chromatic scale, and false for Nil or microtonal pitch classes
+func (from Class) IsChromatic() bool {
+	return from >= C && from <= B
+}
+
+//
+// Private
+//
+
+// natural pitch Class of the Letter, e.g. CLetter is C
+func (l Letter) natural() Class {
+	switch l {
+	case CLetter:
+		return C
+	case DLetter:
+		return D
+	case ELetter:
+		return E
+	case FLetter:
+		return F
+	case GLetter:
+		return G
+	case ALetter:
+		return A
+	case BLetter:
+		return B
+	}
+	return Nil
+}
+
+// nearestAccidental to a letter, for a pitch the given semitones (modulo octaves) from its natural, e.g. 11 semitones is one flat
+func nearestAccidental(semitones int) int {
+	return ((semitones+6)%12+12)%12 - 6
+}
+
+func letterOf(text string) Letter {
+	switch baseNameOf(text) {
+	case C:
+		return CLetter
+	case D:
+		return DLetter
+	case E:
+		return ELetter
+	case F:
+		return FLetter
+	case G:
+		return GLetter
+	case A:
+		return ALetter
+	case B:
+		return BLetter
+	}
+	return NilLetter
+}
@@ -0,0 +1,73 @@
+// A spelled pitch class has a letter name and an accidental, distinguishing enharmonic equivalents such as C# and Db, or E# and F.
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestSpelledNamed(t *testing.T) {
+	assert.Equal(t, Spelled{CLetter, 0}, SpelledNamed("C"))
+	assert.Equal(t, Spelled{ELetter, 1}, SpelledNamed("E#"))
+	assert.Equal(t, Spelled{CLetter, -1}, SpelledNamed("Cb"))
+	assert.Equal(t, Spelled{FLetter, 2}, SpelledNamed("Fx"))
+	assert.Equal(t, Spelled{FLetter, 2}, SpelledNamed("F##"))
+	assert.Equal(t, Spelled{BLetter, -2}, SpelledNamed("B♭♭"))
+	assert.Equal(t, Spelled{GLetter, 1}, SpelledNamed("G#m7"))
+	assert.Equal(t, Spelled{}, SpelledNamed("H"))
+	assert.Equal(t, Spelled{}, SpelledNamed(""))
+}
+
+func TestSpelled_Class(t *testing.T) {
+	assert.Equal(t, F, SpelledNamed("E#").Class())
+	assert.Equal(t, B, SpelledNamed("Cb").Class())
+	assert.Equal(t, G, SpelledNamed("Fx").Class())
+	assert.Equal(t, A, SpelledNamed("Bbb").Class())
+	assert.Equal(t, C, SpelledNamed("B#").Class())
+	assert.Equal(t, Nil, Spelled{}.Class())
+}
+
+func TestSpelled_String(t *testing.T) {
+	assert.Equal(t, "C", Spelled{CLetter, 0}.String())
+	assert.Equal(t, "E#", Spelled{ELetter, 1}.String())
+	assert.Equal(t, "Fx", Spelled{FLetter, 2}.String())
+	assert.Equal(t, "F#x", Spelled{FLetter, 3}.String())
+	assert.Equal(t, "Bbb", Spelled{BLetter, -2}.String())
+	assert.Equal(t, "-", Spelled{}.String())
+}
+
+func TestClass_Spelled(t *testing.T) {
+	assert.Equal(t, Spelled{CLetter, 1}, Cs.Spelled(Sharp))
+	assert.Equal(t, Spelled{DLetter, -1}, Cs.Spelled(Flat))
+	assert.Equal(t, Spelled{CLetter, 1}, Cs.Spelled(No))
+	assert.Equal(t, Spelled{ELetter, 0}, E.Spelled(Flat))
+	assert.Equal(t, Spelled{}, Nil.Spelled(Sharp))
+	assert.Equal(t, Spelled{}, Ch7.Spelled(Sharp))
+}
+
+func TestClass_SpelledAs(t *testing.T) {
+	assert.Equal(t, Spelled{ELetter, 1}, F.SpelledAs(ELetter))
+	assert.Equal(t, Spelled{CLetter, -1}, B.SpelledAs(CLetter))
+	assert.Equal(t, Spelled{ALetter, -2}, G.SpelledAs(ALetter))
+	assert.Equal(t, Spelled{BLetter, 1}, C.SpelledAs(BLetter))
+	assert.Equal(t, Spelled{}, C.SpelledAs(NilLetter))
+}
+
+func TestLetter(t *testing.T) {
+	assert.Equal(t, "C", CLetter.String())
+	assert.Equal(t, "B", BLetter.String())
+	assert.Equal(t, "-", NilLetter.String())
+	assert.Equal(t, ELetter, CLetter.Step(2))
+	assert.Equal(t, CLetter, BLetter.Step(1))
+	assert.Equal(t, BLetter, CLetter.Step(-1))
+	assert.Equal(t, DLetter, CLetter.Step(8))
+	assert.Equal(t, NilLetter, NilLetter.Step(3))
+}
+
+func TestClass_IsChromatic(t *testing.T) {
+	assert.True(t, C.IsChromatic())
+	assert.True(t, B.IsChromatic())
+	assert.False(t, Nil.IsChromatic())
+	assert.False(t, Bh7.IsChromatic())
+}
@@ -0,0 +1,167 @@
+// A tuning system divides the octave into the pitches of the twelve pitch classes, e.g. by pure ratios in just intonation, by a chain of fifths in Pythagorean tuning and the meantone and well temperaments, or evenly in an equal division of the octave.
+package note
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TuningSystem of the twelve pitch classes, each tuned a number of cents above the Tonic, with A4 at the Reference frequency
+type TuningSystem struct {
+	Name      string
+	Tonic     Class  // Pitch class from which the system is tuned, default C
+	Reference Tuning // Frequency of A4, default TuningStandard
+	cents     [12]float64
+}
+
+var ErrTuningSystem = errors.New("no such tuning system")
+
+var (
+	// EqualTemperament of twelve equal semitones
+	EqualTemperament = EDO(12)
+	// JustIntonation5Limit of ratios of the primes 2, 3 and 5, e.g. the major third 5:4
+	JustIntonation5Limit = TuningSystem{Name: "Just Intonation 5-Limit", cents: centsOfRatios(
+		1, 1, 16, 15, 9, 8, 6, 5, 5, 4, 4, 3, 45, 32, 3, 2, 8, 5, 5, 3, 9, 5, 15, 8)}
+	// JustIntonation7Limit of ratios of the primes 2, 3, 5 and 7, e.g. the septimal tritone 7:5 and harmonic seventh 7:4
+	JustIntonation7Limit = TuningSystem{Name: "Just Intonation 7-Limit", cents: centsOfRatios(
+		1, 1, 15, 14, 9, 8, 6, 5, 5, 4, 4, 3, 7, 5, 3, 2, 8, 5, 5, 3, 7, 4, 15, 8)}
+	// Pythagorean tuning of a chain of pure fifths 3:2, from the minor third (e.g. Eb) to the augmented fifth (e.g. G#) above the tonic
+	Pythagorean = TuningSystem{Name: "Pythagorean", cents: centsOfFifths(-3,
+		pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth)}
+	// QuarterCommaMeantone of a chain of fifths each narrowed by a quarter of the syntonic comma, for pure major thirds 5:4, from Eb to G# above the tonic C
+	QuarterCommaMeantone = TuningSystem{Name: "Quarter-Comma Meantone", cents: centsOfFifths(-3,
+		meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth, meantoneFifth)}
+	// WerckmeisterIII well temperament, of the fifths C-G-D-A and B-F# narrowed by a quarter of the Pythagorean comma, and the others pure
+	WerckmeisterIII = TuningSystem{Name: "Werckmeister III", cents: centsOfFifths(0,
+		pureFifth-pythagoreanComma/4, pureFifth-pythagoreanComma/4, pureFifth-pythagoreanComma/4, pureFifth, pureFifth, pureFifth-pythagoreanComma/4, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth)}
+	// Vallotti well temperament, of the fifths F-C-G-D-A-E-B narrowed by a sixth of the Pythagorean comma, and the others pure
+	Vallotti = TuningSystem{Name: "Vallotti", cents: centsOfFifths(-1,
+		pureFifth-pythagoreanComma/6, pureFifth-pythagoreanComma/6, pureFifth-pythagoreanComma/6, pureFifth-pythagoreanComma/6, pureFifth-pythagoreanComma/6, pureFifth-pythagoreanComma/6, pureFifth, pureFifth, pureFifth, pureFifth, pureFifth)}
+)
+
+// EDO divides the octave into the given number of equal steps, e.g. EDO(19), each pitch class tuned to the nearest step along a chain of the fifths of the division, from Eb to G# above the tonic C; EDO(12) is equal temperament
+func EDO(divisions int) TuningSystem {
+	if divisions < 1 {
+		divisions = 12
+	}
+	step := 1200 / float64(divisions)
+	fifth := math.Round(pureFifth/step) * step
+	return TuningSystem{Name: strconv.Itoa(divisions) + "-EDO", cents: centsOfFifths(-3,
+		fifth, fifth, fifth, fifth, fifth, fifth, fifth, fifth, fifth, fifth, fifth)}
+}
+
+// ParseTuningSystem by name, e.g. "pythagorean", "just 5-limit", "meantone", "werckmeister", "vallotti" or "19-EDO", returning ErrTuningSystem if there is no such tuning system
+func ParseTuningSystem(name string) (TuningSystem, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if match := rgxEDO.FindStringSubmatch(name); match != nil {
+		divisions, _ := strconv.Atoi(match[1])
+		if divisions > 0 {
+			return EDO(divisions), nil
+		}
+	}
+	for _, named := range tuningSystemNames {
+		if named.rgx.MatchString(name) {
+			return named.system, nil
+		}
+	}
+	return TuningSystem{}, ErrTuningSystem
+}
+
+// WithTonic returns the tuning system tuned from the given pitch class, e.g. just intonation in D
+func (this TuningSystem) WithTonic(tonic Class) TuningSystem {
+	this.Tonic = tonic
+	return this
+}
+
+// WithReference returns the tuning system with A4 at the given frequency
+func (this TuningSystem) WithReference(reference Tuning) TuningSystem {
+	this.Reference = reference
+	return this
+}
+
+// Cents of the pitch class above the Tonic, from 0 up to 1200, e.g. 386.3 for the major third of just intonation
+func (this TuningSystem) Cents(class Class) float64 {
+	return this.cents[mod12(classToSemitone(class)-classToSemitone(this.tonic()))]
+}
+
+// Frequency in Hz of the Note in this tuning system, with A4 at the Reference frequency, e.g. in Pythagorean tuning with the tonic A, E5 is 660Hz
+func (this TuningSystem) Frequency(n *Note) float64 {
+	reference := this.Reference
+	if reference == 0 {
+		reference = TuningStandard
+	}
+	return float64(reference) * math.Pow(2, (this.centsOf(n.MIDI())-this.centsOf(69))/1200)
+}
+
+//
+// Private
+//
+
+// Intervals in cents
+var (
+	pureFifth        = 1200 * math.Log2(3.0/2)
+	meantoneFifth    = 1200 * math.Log2(5) / 4
+	pythagoreanComma = 12*pureFifth - 7*1200
+)
+
+var rgxEDO = regexp.MustCompile("^([0-9]+)[ -]*(edo|tet|et)$")
+
+var tuningSystemNames = []struct {
+	rgx    *regexp.Regexp
+	system TuningSystem
+}{
+	{regexp.MustCompile("^(equal|equal temperament|12-?tet)$"), EqualTemperament},
+	{regexp.MustCompile("^(ji|just|just intonation)?[ -]*7[ -]*limit$"), JustIntonation7Limit},
+	{regexp.MustCompile("^(ji|just|just intonation)([ -]*5[ -]*limit)?$|^5[ -]*limit$"), JustIntonation5Limit},
+	{regexp.MustCompile("^pythagorean$"), Pythagorean},
+	{regexp.MustCompile("^(quarter[ -]*comma[ -]*)?meantone$"), QuarterCommaMeantone},
+	{regexp.MustCompile("^werckmeister([ -]*(iii|3))?$"), WerckmeisterIII},
+	{regexp.MustCompile("^vallotti$"), Vallotti},
+}
+
+// tonic of the tuning system, default C
+func (this TuningSystem) tonic() Class {
+	if !this.Tonic.IsChromatic() {
+		return C
+	}
+	return this.Tonic
+}
+
+// centsOf a MIDI note number, above the tonic in octave -1
+func (this TuningSystem) centsOf(midiNote int) float64 {
+	semitones := midiNote - classToSemitone(this.tonic())
+	octaves := int(math.Floor(float64(semitones) / 12))
+	return 1200*float64(octaves) + this.cents[semitones-12*octaves]
+}
+
+// centsOfRatios of each semitone above the tonic, given as twelve pairs of numerator and denominator
+func centsOfRatios(ratios ...float64) (cents [12]float64) {
+	for i := range cents {
+		cents[i] = 1200 * math.Log2(ratios[2*i]/ratios[2*i+1])
+	}
+	return
+}
+
+// centsOfFifths of each semitone above the tonic, along a chain of eleven fifths of the given sizes in cents, beginning the given number of fifths from the tonic, e.g. -3 for Eb above C
+func centsOfFifths(from int, fifths ...float64) (cents [12]float64) {
+	var chain [12]float64
+	for i, fifth := range fifths {
+		chain[i+1] = chain[i] + fifth
+	}
+	tonic := chain[-from]
+	for i := range chain {
+		c := math.Mod(chain[i]-tonic, 1200)
+		if c < 0 {
+			c += 1200
+		}
+		cents[mod12(7*(from+i))] = c
+	}
+	return
+}
+
+func mod12(i int) int {
+	return (i%12 + 12) % 12
+}
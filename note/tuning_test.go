@@ -0,0 +1,128 @@
+// A tuning system divides the octave into the pitches of the twelve pitch classes, e.g. by pure ratios in just intonation, by a chain of fifths in Pythagorean tuning and the meantone and well temperaments, or evenly in an equal division of the octave.
+package note
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+)
+
+func TestEqualTemperament(t *testing.T) {
+	for m := 0; m < 128; m++ {
+		n := FromMIDI(m, Sharp)
+		assert.InDelta(t, TuningStandard.Frequency(n), EqualTemperament.Frequency(n), 0.0000001)
+		assert.InDelta(t, TuningVerdi.Frequency(n), EqualTemperament.WithTonic(D).WithReference(TuningVerdi).Frequency(n), 0.0000001)
+	}
+	assert.Equal(t, "12-EDO", EqualTemperament.Name)
+}
+
+func TestTuningSystem_Cents(t *testing.T) {
+	assertCents(t, EqualTemperament, 0, 100, 200, 300, 400, 500, 600, 700, 800, 900, 1000, 1100)
+	assertCents(t, JustIntonation5Limit, 0, 111.73, 203.91, 315.64, 386.31, 498.04, 590.22, 701.96, 813.69, 884.36, 1017.60, 1088.27)
+	assertCents(t, JustIntonation7Limit, 0, 119.44, 203.91, 315.64, 386.31, 498.04, 582.51, 701.96, 813.69, 884.36, 968.83, 1088.27)
+	assertCents(t, Pythagorean, 0, 113.69, 203.91, 294.13, 407.82, 498.04, 611.73, 701.96, 815.64, 905.87, 996.09, 1109.78)
+	assertCents(t, QuarterCommaMeantone, 0, 76.05, 193.16, 310.26, 386.31, 503.42, 579.47, 696.58, 772.63, 889.74, 1006.84, 1082.89)
+	assertCents(t, WerckmeisterIII, 0, 90.22, 192.18, 294.13, 390.22, 498.04, 588.27, 696.09, 792.18, 888.27, 996.09, 1092.18)
+	assertCents(t, Vallotti, 0, 94.13, 196.09, 298.04, 392.18, 501.96, 592.18, 698.04, 796.09, 894.13, 1000, 1090.22)
+	assertCents(t, EDO(19), 0, 63.16, 189.47, 315.79, 378.95, 505.26, 568.42, 694.74, 757.89, 884.21, 1010.53, 1073.68)
+	assertCents(t, EDO(24), 0, 100, 200, 300, 400, 500, 600, 700, 800, 900, 1000, 1100)
+}
+
+func TestTuningSystem_WithTonic(t *testing.T) {
+	just := JustIntonation5Limit.WithTonic(D)
+	assert.Equal(t, 0.0, just.Cents(D))
+	assert.InDelta(t, 386.31, just.Cents(Fs), 0.01)
+	assert.InDelta(t, 701.96, just.Cents(A), 0.01)
+	assert.InDelta(t, 1088.27, just.Cents(Cs), 0.01)
+	assert.Equal(t, D, just.Tonic)
+	assert.Equal(t, Nil, JustIntonation5Limit.Tonic)
+}
+
+func TestTuningSystem_Frequency(t *testing.T) {
+	// A4 is always the reference frequency
+	for _, system := range []TuningSystem{JustIntonation5Limit, JustIntonation7Limit, Pythagorean, QuarterCommaMeantone, WerckmeisterIII, Vallotti, EDO(31)} {
+		for _, tonic := range []Class{C, D, F, A} {
+			assert.InDelta(t, 440, system.WithTonic(tonic).Frequency(Named("A4")), 0.0000001, system.Name)
+			assert.InDelta(t, 220, system.WithTonic(tonic).Frequency(Named("A3")), 0.0000001, system.Name)
+		}
+	}
+
+	// pure intervals above the tonic A
+	pythagorean := Pythagorean.WithTonic(A)
+	assert.InDelta(t, 660, pythagorean.Frequency(Named("E5")), 0.0000001)
+	assert.InDelta(t, 495, pythagorean.Frequency(Named("B4")), 0.0000001)
+	just := JustIntonation5Limit.WithTonic(A)
+	assert.InDelta(t, 550, just.Frequency(Named("C#5")), 0.0000001)
+	assert.InDelta(t, 586.6666667, just.Frequency(Named("D5")), 0.0000001)
+	assert.InDelta(t, 293.3333333, just.Frequency(Named("D4")), 0.0000001)
+	septimal := JustIntonation7Limit.WithTonic(A)
+	assert.InDelta(t, 770, septimal.Frequency(Named("G5")), 0.0000001)
+
+	// tuned from C, A4 is at the reference frequency, and C5 a just major sixth above it
+	assert.InDelta(t, 528, JustIntonation5Limit.Frequency(Named("C5")), 0.0000001)
+	assert.InDelta(t, 264, JustIntonation5Limit.Frequency(Named("C4")), 0.0000001)
+	assert.InDelta(t, 518.4, JustIntonation5Limit.WithReference(TuningVerdi).Frequency(Named("C5")), 0.0000001)
+}
+
+func TestNotePitchIn(t *testing.T) {
+	assert.InDelta(t, 660, Named("E5").PitchIn(Pythagorean.WithTonic(A)), 0.0000001)
+	assert.InDelta(t, 432, Named("A4").PitchIn(TuningVerdi), 0.0000001)
+	assert.InDelta(t, 440, Named("A4").PitchIn(nil), 0.0000001)
+	assert.InDelta(t, Named("C5").Pitch(440), Named("C5").PitchIn(EqualTemperament), 0.0000001)
+}
+
+func TestFromFrequency_TuningSystem(t *testing.T) {
+	n, cents := FromFrequency(660, Pythagorean.WithTonic(A))
+	assert.Equal(t, "E5", n.String())
+	assert.InDelta(t, 0, cents, 0.0000001)
+
+	n, cents = FromFrequency(550, TuningStandard)
+	assert.Equal(t, "C#5", n.String())
+	assert.InDelta(t, -13.69, cents, 0.01)
+
+	n, cents = FromFrequency(550, JustIntonation5Limit.WithTonic(A))
+	assert.Equal(t, "C#5", n.String())
+	assert.InDelta(t, 0, cents, 0.0000001)
+}
+
+func TestParseTuningSystem(t *testing.T) {
+	for name, expect := range map[string]string{
+		"equal":                  "12-EDO",
+		"12-TET":                 "12-EDO",
+		"just":                   "Just Intonation 5-Limit",
+		"Just Intonation":        "Just Intonation 5-Limit",
+		"just 5-limit":           "Just Intonation 5-Limit",
+		"5-limit":                "Just Intonation 5-Limit",
+		"ji 7-limit":             "Just Intonation 7-Limit",
+		"7-limit":                "Just Intonation 7-Limit",
+		"Pythagorean":            "Pythagorean",
+		"meantone":               "Quarter-Comma Meantone",
+		"quarter-comma meantone": "Quarter-Comma Meantone",
+		"Werckmeister":           "Werckmeister III",
+		"werckmeister iii":       "Werckmeister III",
+		"werckmeister 3":         "Werckmeister III",
+		"Vallotti":               "Vallotti",
+		"19-EDO":                 "19-EDO",
+		"31edo":                  "31-EDO",
+		"53 EDO":                 "53-EDO",
+		"24-TET":                 "24-EDO",
+	} {
+		system, err := ParseTuningSystem(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, expect, system.Name, name)
+	}
+	for _, name := range []string{"", "kirnberger", "0-EDO", "440"} {
+		_, err := ParseTuningSystem(name)
+		assert.Equal(t, ErrTuningSystem, err, name)
+	}
+}
+
+//
+// Private
+//
+
+func assertCents(t *testing.T, system TuningSystem, expect ...float64) {
+	for i, class := range []Class{C, Cs, D, Ds, E, F, Fs, G, Gs, A, As, B} {
+		assert.InDelta(t, expect[i], system.Cents(class), 0.01, system.Name+" "+class.String(Sharp))
+	}
+}
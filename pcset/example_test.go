@@ -0,0 +1,43 @@
+package pcset_test
+
+import (
+	"fmt"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/pcset"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Example demonstrates the set class of the Tristan chord
+func Example() {
+	tristan := pcset.Of(note.F, note.B, note.Ds, note.Gs)
+	fmt.Printf("Forte: %s\n", tristan.Forte())
+	fmt.Printf("Prime form: %v\n", tristan.PrimeForm())
+	fmt.Printf("Interval vector: %v\n", tristan.IntervalVector())
+	// Output:
+	// Forte: 4-27
+	// Prime form: [0 2 5 8]
+	// Interval vector: [0 1 2 1 1 1]
+}
+
+// ExampleOfChord demonstrates the set of the tones of a chord, as a subset of a scale
+func ExampleOfChord() {
+	g7 := pcset.OfChord(chord.Of("G7"))
+	fmt.Printf("%s %s\n", g7, g7.Forte())
+	fmt.Printf("In C major: %v\n", g7.IsSubsetOf(pcset.OfScale(scale.Of("C major"))))
+	// Output:
+	// {2,5,7,E} 4-27
+	// In C major: true
+}
+
+// ExampleSet_ZPartner demonstrates the Z-related set classes sharing an interval vector
+func ExampleSet_ZPartner() {
+	s, _ := pcset.OfForte("4-Z15")
+	partner, _ := s.ZPartner()
+	fmt.Printf("%s %v\n", s.Forte(), s.IntervalVector())
+	fmt.Printf("%s %v\n", partner.Forte(), partner.IntervalVector())
+	// Output:
+	// 4-Z15 [1 1 1 1 1 1]
+	// 4-Z29 [1 1 1 1 1 1]
+}
@@ -0,0 +1,78 @@
+// The normal form of a pitch-class set is its most compact ordering, and the prime form is the most compact of its normal form and that of its inversion, transposed to begin on 0.
+package pcset
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// NormalForm of the Set, its pitch classes ordered as an ascending rotation spanning the smallest interval, e.g. [B D F] for {2,5,11}.
+// Ties are broken as Forte does, by the smallest interval from the first pitch class to the second-to-last, then the third-to-last, and so on, and then by the lowest first pitch class.
+func (this Set) NormalForm() (classes []note.Class) {
+	for _, i := range normalOrder(this.Integers()) {
+		classes = append(classes, classOf(i))
+	}
+	return
+}
+
+// PrimeForm of the Set, the more compact of its normal form and the normal form of its inversion, transposed to begin on 0, e.g. [0 1 4] for both {C, C#, E} and {C, D#, E}
+func (this Set) PrimeForm() []int {
+	if this.Len() == 0 {
+		return []int{}
+	}
+	return prime(this).Integers()
+}
+
+//
+// Private
+//
+
+// normalOrder of pitch classes given in ascending order
+func normalOrder(integers []int) []int {
+	if len(integers) == 0 {
+		return nil
+	}
+	var best []int
+	for r := range integers {
+		rotation := append(append([]int{}, integers[r:]...), integers[:r]...)
+		if best == nil || isMoreCompact(rotation, best) {
+			best = rotation
+		}
+	}
+	return best
+}
+
+// isMoreCompact is true if the first ordering spans a smaller interval than the second, comparing from the first pitch class to the last, then to the second-to-last, the third-to-last, and so on, as Forte does (where Rahn compares to the second, the third, and so on)
+func isMoreCompact(a []int, b []int) bool {
+	spanA, spanB := mod12(a[len(a)-1]-a[0]), mod12(b[len(b)-1]-b[0])
+	if spanA != spanB {
+		return spanA < spanB
+	}
+	for k := len(a) - 2; k > 0; k-- {
+		da, db := mod12(a[k]-a[0]), mod12(b[k]-b[0])
+		if da != db {
+			return da < db
+		}
+	}
+	return a[0] < b[0]
+}
+
+// prime form of the Set, as a Set transposed to begin on 0
+func prime(s Set) Set {
+	if s.Len() == 0 {
+		return 0
+	}
+	original := zeroed(normalOrder(s.Integers()))
+	inverted := zeroed(normalOrder(s.Invert(0).Integers()))
+	if isMoreCompact(inverted, original) {
+		return OfIntegers(inverted...)
+	}
+	return OfIntegers(original...)
+}
+
+// zeroed ordering, transposed to begin on 0
+func zeroed(order []int) (integers []int) {
+	for _, i := range order {
+		integers = append(integers, mod12(i-order[0]))
+	}
+	return
+}
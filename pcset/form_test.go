@@ -0,0 +1,33 @@
+// The normal form of a pitch-class set is its most compact ordering, and the prime form is the most compact of its normal form and that of its inversion, transposed to begin on 0.
+package pcset
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestNormalForm(t *testing.T) {
+	assert.Equal(t, []note.Class{note.B, note.D, note.F}, OfIntegers(2, 5, 11).NormalForm())
+	assert.Equal(t, []note.Class{note.C, note.E, note.G}, OfIntegers(0, 4, 7).NormalForm())
+	assert.Equal(t, []note.Class{note.Ds, note.F, note.Gs, note.B}, OfIntegers(5, 11, 3, 8).NormalForm()) // Tristan chord
+	assert.Equal(t, []note.Class{note.C, note.Ds, note.Fs, note.A}, OfIntegers(9, 6, 3, 0).NormalForm())  // symmetric, begins on the lowest
+	assert.Equal(t, []note.Class(nil), Set(0).NormalForm())
+}
+
+func TestNormalForm_Ties(t *testing.T) {
+	// {0,1,3,7} is most compact from 0 or from 7 (span 7); Forte prefers the smaller interval from the first to the second-to-last
+	assert.Equal(t, []note.Class{note.C, note.Cs, note.Ds, note.G}, OfIntegers(0, 1, 3, 7).NormalForm())
+}
+
+func TestPrimeForm(t *testing.T) {
+	assert.Equal(t, []int{0, 3, 7}, OfIntegers(0, 4, 7).PrimeForm())
+	assert.Equal(t, []int{0, 3, 7}, OfIntegers(0, 3, 7).PrimeForm())
+	assert.Equal(t, []int{0, 1, 4}, OfIntegers(0, 1, 4).PrimeForm())
+	assert.Equal(t, []int{0, 1, 4}, OfIntegers(0, 3, 4).PrimeForm())
+	assert.Equal(t, []int{0, 2, 5, 8}, OfIntegers(5, 11, 3, 8).PrimeForm())
+	assert.Equal(t, []int{0, 1, 5, 6, 8}, OfIntegers(0, 1, 3, 7, 8).PrimeForm()) // Forte's 5-20, where Rahn has [0 1 3 7 8]
+	assert.Equal(t, []int{}, Set(0).PrimeForm())
+}
@@ -0,0 +1,115 @@
+// Set classes are named by their Forte number, the cardinality and ordinal position in Allen Forte's list, e.g. 3-11 for major and minor triads, with a Z for sets that share an interval vector with another class, e.g. 4-Z15 and 4-Z29.
+package pcset
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Forte number of the set class of this Set, e.g. "4-27" for the Tristan chord, or "4-Z15"
+func (this Set) Forte() string {
+	return forteNames[prime(this)]
+}
+
+// ZPartner is the prime form of the set class that shares the interval vector of this Set without being equivalent to it, e.g. 4-Z29 for 4-Z15, or false if this Set is not Z-related to any other
+func (this Set) ZPartner() (Set, bool) {
+	p := prime(this)
+	if !strings.Contains(forteNames[p], "Z") {
+		return 0, false
+	}
+	vector := p.IntervalVector()
+	for other := range forteNames {
+		if other != p && other.Len() == p.Len() && other.IntervalVector() == vector {
+			return other, true
+		}
+	}
+	return 0, false
+}
+
+// IsZRelated to the other Set, if both share an interval vector but are not of the same set class
+func (this Set) IsZRelated(other Set) bool {
+	return this.IntervalVector() == other.IntervalVector() && !this.IsEquivalent(other)
+}
+
+// OfForte number, e.g. "4-27" or "4-Z15", the prime form of the named set class, or false if there is no such set class
+func OfForte(name string) (Set, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	for s, n := range forteNames {
+		if n == name || strings.Replace(n, "Z", "", 1) == name {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+//
+// Private
+//
+
+// forteNames of every set class, by its prime form
+var forteNames = make(map[Set]string)
+
+// Prime forms of the set classes of three to six pitch classes, in Forte's order, from which the others are derived: classes of two pitch classes by their interval class, and classes of seven to nine by complement
+var fortePrimes = map[int][]string{
+	3: {
+		"012", "013", "014", "015", "016", "024", "025", "026", "027", "036", "037", "048",
+	},
+	4: {
+		"0123", "0124", "0134", "0125", "0126", "0127", "0145", "0156", "0167", "0235",
+		"0135", "0236", "0136", "0237", "Z0146", "0157", "0347", "0147", "0148", "0158",
+		"0246", "0247", "0257", "0248", "0268", "0358", "0258", "0369", "Z0137",
+	},
+	5: {
+		"01234", "01235", "01245", "01236", "01237", "01256", "01267", "02346", "01246", "01346",
+		"02347", "Z01356", "01248", "01257", "01268", "01347", "Z01348", "Z01457", "01367", "01568",
+		"01458", "01478", "02357", "01357", "02358", "02458", "01358", "02368", "01368", "01468",
+		"01369", "01469", "02468", "02469", "02479", "Z01247", "Z03458", "Z01258",
+	},
+	6: {
+		"012345", "012346", "Z012356", "Z012456", "012367", "Z012567", "012678", "023457", "012357", "Z013457",
+		"Z012457", "Z012467", "Z013467", "013458", "012458", "014568", "Z012478", "012578", "Z013478", "014589",
+		"023468", "012468", "Z023568", "Z013468", "Z013568", "Z013578", "013469", "Z013569", "Z023679", "013679",
+		"014579", "024579", "023579", "013579", "02468T", "Z012347", "Z012348", "Z012378", "Z023458", "Z012358",
+		"Z012368", "Z012369", "Z012568", "Z012569", "Z023469", "Z012469", "Z012479", "Z012579", "Z013479", "Z014679",
+	},
+}
+
+func init() {
+	forteNames[0] = "0-1"
+	forteNames[all] = "12-1"
+	forteNames[OfIntegers(0)] = "1-1"
+	forteNames[prime(OfIntegers(0).Complement())] = "11-1"
+	for ic := 1; ic <= 6; ic++ {
+		forteNames[OfIntegers(0, ic)] = "2-" + strconv.Itoa(ic)
+		forteNames[prime(OfIntegers(0, ic).Complement())] = "10-" + strconv.Itoa(ic)
+	}
+	for cardinality, primes := range fortePrimes {
+		for i, text := range primes {
+			z := ""
+			if strings.HasPrefix(text, "Z") {
+				z = "Z"
+				text = text[1:]
+			}
+			p := ofPrimeString(text)
+			forteNames[p] = strconv.Itoa(cardinality) + "-" + z + strconv.Itoa(i+1)
+			if cardinality < 6 {
+				forteNames[prime(p.Complement())] = strconv.Itoa(12-cardinality) + "-" + z + strconv.Itoa(i+1)
+			}
+		}
+	}
+}
+
+// ofPrimeString of digits, using T for 10 and E for 11, e.g. "02468T"
+func ofPrimeString(text string) (s Set) {
+	for _, r := range text {
+		switch r {
+		case 'T':
+			s |= OfIntegers(10)
+		case 'E':
+			s |= OfIntegers(11)
+		default:
+			s |= OfIntegers(int(r - '0'))
+		}
+	}
+	return
+}
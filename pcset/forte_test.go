@@ -0,0 +1,126 @@
+// Set classes are named by their Forte number, the cardinality and ordinal position in Allen Forte's list, e.g. 3-11 for major and minor triads, with a Z for sets that share an interval vector with another class, e.g. 4-Z15 and 4-Z29.
+package pcset
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestForte(t *testing.T) {
+	assert.Equal(t, "3-11", Of(note.C, note.E, note.G).Forte())
+	assert.Equal(t, "3-11", Of(note.C, note.Ds, note.G).Forte())
+	assert.Equal(t, "4-27", OfIntegers(5, 11, 3, 8).Forte())       // Tristan chord, F B D# G#
+	assert.Equal(t, "6-30", OfIntegers(0, 4, 7, 6, 10, 1).Forte()) // Petrushka chord, C major and F# major
+	assert.Equal(t, "7-35", OfScale(scale.Of("C major")).Forte())
+	assert.Equal(t, "6-35", OfScale(scale.Of("C whole tone")).Forte())
+	assert.Equal(t, "8-28", OfScale(scale.Of("C diminished")).Forte())
+	assert.Equal(t, "5-35", OfScale(scale.Of("C pentatonic")).Forte())
+	assert.Equal(t, "4-Z15", OfIntegers(0, 1, 4, 6).Forte())
+	assert.Equal(t, "2-6", OfIntegers(0, 6).Forte())
+	assert.Equal(t, "0-1", Set(0).Forte())
+	assert.Equal(t, "12-1", OfIntegers(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11).Forte())
+}
+
+func TestForte_Forms(t *testing.T) {
+	assert.Equal(t, "6-21", OfChord(chord.Of("C tristan")).Forte()) // C E F# G G# Bb
+	assert.Equal(t, "6-21", OfChord(chord.Of("Eb tristan")).Forte())
+	assert.Equal(t, "4-Z29", OfChord(chord.Of("C petrushka")).Forte()) // C E F# G
+}
+
+func TestForte_AllSetClasses(t *testing.T) {
+	counts := make(map[int]int)
+	for _, name := range forteNames {
+		counts[cardinalityOf(name)]++
+	}
+	assert.Equal(t, map[int]int{0: 1, 1: 1, 2: 6, 3: 12, 4: 29, 5: 38, 6: 50, 7: 38, 8: 29, 9: 12, 10: 6, 11: 1, 12: 1}, counts)
+
+	for s := Set(0); s <= all; s++ {
+		name, ok := forteNames[prime(s)]
+		assert.True(t, ok, s.String())
+		assert.Equal(t, s.Len(), cardinalityOf(name), s.String())
+	}
+	for _, primes := range fortePrimes {
+		for _, text := range primes {
+			p := ofPrimeString(strings.TrimPrefix(text, "Z"))
+			assert.Equal(t, p, prime(p), text)
+		}
+	}
+}
+
+func TestForte_Packing(t *testing.T) {
+	// the set classes whose prime forms differ between Forte's packing and Rahn's
+	s, ok := OfForte("5-20")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 5, 6, 8}, s.PrimeForm()) // Rahn has [0 1 3 7 8]
+	s, ok = OfForte("6-Z29")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 3, 6, 7, 9}, s.PrimeForm()) // Rahn has [0 1 3 6 8 9]
+	s, ok = OfForte("6-31")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4, 5, 7, 9}, s.PrimeForm()) // Rahn has [0 1 3 5 8 9]
+	assert.Equal(t, "5-20", OfIntegers(0, 1, 3, 7, 8).Forte())
+	assert.Equal(t, "6-Z29", OfIntegers(0, 1, 3, 6, 8, 9).Forte())
+	assert.Equal(t, "6-31", OfIntegers(0, 1, 3, 5, 8, 9).Forte())
+}
+
+func TestForte_ZRelations(t *testing.T) {
+	for p, name := range forteNames {
+		partner, ok := p.ZPartner()
+		assert.Equal(t, strings.Contains(name, "Z"), ok, name)
+		if ok {
+			assert.True(t, p.IsZRelated(partner), name)
+			assert.True(t, strings.Contains(forteNames[partner], "Z"), name)
+			assert.Equal(t, cardinalityOf(name), cardinalityOf(forteNames[partner]), name)
+		}
+	}
+	partner, ok := OfIntegers(0, 1, 4, 6).ZPartner()
+	assert.True(t, ok)
+	assert.Equal(t, "4-Z29", partner.Forte())
+	assert.True(t, OfIntegers(0, 1, 4, 6).IsZRelated(OfIntegers(0, 1, 3, 7)))
+	assert.False(t, OfIntegers(0, 4, 7).IsZRelated(OfIntegers(0, 3, 7)))
+	_, ok = OfIntegers(0, 4, 7).ZPartner()
+	assert.False(t, ok)
+}
+
+func TestForte_Complements(t *testing.T) {
+	for p, name := range forteNames {
+		complement := forteNames[prime(p.Complement())]
+		if partner, ok := p.ZPartner(); ok && p.Len() == 6 {
+			assert.Equal(t, forteNames[partner], complement, name) // the complement of a Z-related hexachord is its partner
+			continue
+		}
+		assert.Equal(t, strings.SplitN(name, "-", 2)[1], strings.SplitN(complement, "-", 2)[1], name)
+	}
+}
+
+func TestOfForte(t *testing.T) {
+	s, ok := OfForte("4-27")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 5, 8}, s.PrimeForm())
+	s, ok = OfForte("4-z15")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4, 6}, s.PrimeForm())
+	s, ok = OfForte("4-15")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4, 6}, s.PrimeForm())
+	_, ok = OfForte("4-30")
+	assert.False(t, ok)
+}
+
+//
+// Private
+//
+
+func cardinalityOf(name string) int {
+	n := 0
+	for _, r := range strings.SplitN(name, "-", 2)[0] {
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
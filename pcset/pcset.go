@@ -0,0 +1,205 @@
+// In music theory, a pitch-class set is an unordered collection of pitch classes, analysed up to transposition and inversion as a set class, e.g. the Tristan chord is 4-27.
+//
+// https://en.wikipedia.org/wiki/Set_theory_(music)
+//
+// Pitch classes are counted as integers from C = 0 to B = 11.
+//
+// # Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+package pcset
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+// Set of pitch classes, with one bit for each of the twelve chromatic classes from C = 0 to B = 11
+type Set uint16
+
+// Of the given pitch classes; microtonal classes are ignored
+func Of(classes ...note.Class) (s Set) {
+	for _, class := range classes {
+		if class.IsChromatic() {
+			s |= 1 << uint(integerOf(class))
+		}
+	}
+	return
+}
+
+// OfIntegers from C = 0 to B = 11, e.g. OfIntegers(0, 4, 7) is C major; each is taken modulo 12
+func OfIntegers(integers ...int) (s Set) {
+	for _, i := range integers {
+		s |= 1 << uint(mod12(i))
+	}
+	return
+}
+
+// OfChord is the set of all tones of the Chord, including its slash bass
+func OfChord(c chord.Chord) Set {
+	var classes []note.Class
+	for _, class := range c.Tones {
+		classes = append(classes, class)
+	}
+	return Of(append(classes, c.Bass)...)
+}
+
+// OfScale is the set of all tones of the Scale
+func OfScale(s scale.Scale) Set {
+	var classes []note.Class
+	for _, class := range s.Tones {
+		classes = append(classes, class)
+	}
+	return Of(classes...)
+}
+
+// Len is the number of pitch classes in the Set, its cardinality
+func (this Set) Len() int {
+	return bits.OnesCount16(uint16(this & all))
+}
+
+// Contains the given pitch class
+func (this Set) Contains(class note.Class) bool {
+	return class.IsChromatic() && this&(1<<uint(integerOf(class))) != 0
+}
+
+// Integers of the Set, from C = 0 to B = 11, in ascending order
+func (this Set) Integers() (integers []int) {
+	for i := 0; i < 12; i++ {
+		if this&(1<<uint(i)) != 0 {
+			integers = append(integers, i)
+		}
+	}
+	return
+}
+
+// Classes of the Set, in ascending order from C
+func (this Set) Classes() (classes []note.Class) {
+	for _, i := range this.Integers() {
+		classes = append(classes, classOf(i))
+	}
+	return
+}
+
+// String of the Set as its integers, using T for 10 and E for 11, e.g. "{0,4,7,E}"
+func (this Set) String() string {
+	return "{" + strings.Join(integerStrings(this.Integers()), ",") + "}"
+}
+
+// Transpose the Set by n semitones (Tn)
+func (this Set) Transpose(n int) Set {
+	n = mod12(n)
+	s := uint16(this & all)
+	return Set((s<<uint(n) | s>>uint(12-n)) & uint16(all))
+}
+
+// Invert the Set and then transpose it by n semitones (TnI), mapping each pitch class x to n - x
+func (this Set) Invert(n int) (s Set) {
+	for _, i := range this.Integers() {
+		s |= 1 << uint(mod12(n-i))
+	}
+	return
+}
+
+// TranspositionTo the other Set, returning n such that Tn of this Set is the other, or false if there is none
+func (this Set) TranspositionTo(other Set) (int, bool) {
+	for n := 0; n < 12; n++ {
+		if this.Transpose(n) == other {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// InversionTo the other Set, returning n such that TnI of this Set is the other, or false if there is none
+func (this Set) InversionTo(other Set) (int, bool) {
+	for n := 0; n < 12; n++ {
+		if this.Invert(n) == other {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// IsEquivalent is true if the other Set is a transposition (Tn) or inversion (TnI) of this Set, i.e. both are of the same set class
+func (this Set) IsEquivalent(other Set) bool {
+	_, tn := this.TranspositionTo(other)
+	_, tni := this.InversionTo(other)
+	return tn || tni
+}
+
+// Complement of the Set, all the pitch classes not in it
+func (this Set) Complement() Set {
+	return ^this & all
+}
+
+// IsSubsetOf the other Set, if every pitch class of this Set is in the other
+func (this Set) IsSubsetOf(other Set) bool {
+	return this&^other == 0
+}
+
+// IsSupersetOf the other Set, if every pitch class of the other Set is in this one
+func (this Set) IsSupersetOf(other Set) bool {
+	return other.IsSubsetOf(this)
+}
+
+// IntervalVector of the Set, counting the pairs of pitch classes in each interval class 1 to 6, e.g. <0,0,1,1,1,0> for a major triad
+func (this Set) IntervalVector() (vector [6]int) {
+	integers := this.Integers()
+	for i := range integers {
+		for j := i + 1; j < len(integers); j++ {
+			ic := integers[j] - integers[i]
+			if ic > 6 {
+				ic = 12 - ic
+			}
+			vector[ic-1]++
+		}
+	}
+	return
+}
+
+//
+// Private
+//
+
+// all twelve chromatic pitch classes
+const all Set = 1<<12 - 1
+
+// integerOf a chromatic pitch class, from C = 0 to B = 11
+func integerOf(class note.Class) int {
+	return int(class - note.C)
+}
+
+// classOf an integer pitch class, from C = 0 to B = 11
+func classOf(i int) note.Class {
+	return note.C + note.Class(mod12(i))
+}
+
+func mod12(i int) int {
+	return (i%12 + 12) % 12
+}
+
+// integerStrings of pitch classes, using T for 10 and E for 11
+func integerStrings(integers []int) (strs []string) {
+	for _, i := range integers {
+		switch i {
+		case 10:
+			strs = append(strs, "T")
+		case 11:
+			strs = append(strs, "E")
+		default:
+			strs = append(strs, strconv.Itoa(i))
+		}
+	}
+	return
+}
@@ -0,0 +1,88 @@
+// In music theory, a pitch-class set is an unordered collection of pitch classes, analysed up to transposition and inversion as a set class, e.g. the Tristan chord is 4-27.
+package pcset
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+	"github.com/go-music-theory/music-theory/scale"
+)
+
+func TestOf(t *testing.T) {
+	s := Of(note.E, note.C, note.G, note.C, note.Ch7)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{0, 4, 7}, s.Integers())
+	assert.Equal(t, []note.Class{note.C, note.E, note.G}, s.Classes())
+	assert.Equal(t, s, OfIntegers(12, 16, -5))
+	assert.Equal(t, "{0,4,7}", s.String())
+	assert.Equal(t, "{T,E}", OfIntegers(10, 11).String())
+	assert.True(t, s.Contains(note.E))
+	assert.False(t, s.Contains(note.F))
+	assert.False(t, s.Contains(note.Nil))
+}
+
+func TestOfChord(t *testing.T) {
+	assert.Equal(t, OfIntegers(0, 4, 7, 10), OfChord(chord.Of("C7")))
+	assert.Equal(t, OfIntegers(0, 4, 7, 2), OfChord(chord.Of("C/D")))
+	assert.Equal(t, Set(0), OfChord(chord.Chord{}))
+}
+
+func TestOfScale(t *testing.T) {
+	assert.Equal(t, OfIntegers(2, 4, 5, 7, 9, 11, 0), OfScale(scale.Of("D dorian")))
+	assert.Equal(t, Set(0), OfScale(scale.Scale{}))
+}
+
+func TestTranspose(t *testing.T) {
+	assert.Equal(t, OfIntegers(2, 6, 9), OfIntegers(0, 4, 7).Transpose(2))
+	assert.Equal(t, OfIntegers(11, 3, 6), OfIntegers(0, 4, 7).Transpose(-1))
+	assert.Equal(t, OfIntegers(0, 4, 7), OfIntegers(0, 4, 7).Transpose(12))
+}
+
+func TestInvert(t *testing.T) {
+	assert.Equal(t, OfIntegers(0, 8, 5), OfIntegers(0, 4, 7).Invert(0))
+	assert.Equal(t, OfIntegers(7, 3, 0), OfIntegers(0, 4, 7).Invert(7)) // C major to C minor
+}
+
+func TestTranspositionTo(t *testing.T) {
+	n, ok := OfIntegers(0, 4, 7).TranspositionTo(OfIntegers(9, 1, 4))
+	assert.True(t, ok)
+	assert.Equal(t, 9, n)
+	_, ok = OfIntegers(0, 4, 7).TranspositionTo(OfIntegers(0, 3, 7))
+	assert.False(t, ok)
+}
+
+func TestInversionTo(t *testing.T) {
+	n, ok := OfIntegers(0, 4, 7).InversionTo(OfIntegers(0, 3, 7))
+	assert.True(t, ok)
+	assert.Equal(t, 7, n)
+	_, ok = OfIntegers(0, 1, 2).InversionTo(OfIntegers(0, 4, 7))
+	assert.False(t, ok)
+}
+
+func TestIsEquivalent(t *testing.T) {
+	assert.True(t, OfIntegers(0, 4, 7).IsEquivalent(OfIntegers(2, 5, 9)))
+	assert.False(t, OfIntegers(0, 4, 7).IsEquivalent(OfIntegers(0, 4, 8)))
+}
+
+func TestComplement(t *testing.T) {
+	assert.Equal(t, OfIntegers(1, 3, 6, 8, 10), OfScale(scale.Of("C major")).Complement())
+	assert.Equal(t, Set(0), OfIntegers(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11).Complement())
+}
+
+func TestIsSubsetOf(t *testing.T) {
+	major := OfScale(scale.Of("C major"))
+	assert.True(t, OfChord(chord.Of("G7")).IsSubsetOf(major))
+	assert.False(t, OfChord(chord.Of("D7")).IsSubsetOf(major))
+	assert.True(t, major.IsSupersetOf(OfChord(chord.Of("Dm7"))))
+	assert.True(t, Set(0).IsSubsetOf(major))
+}
+
+func TestIntervalVector(t *testing.T) {
+	assert.Equal(t, [6]int{0, 0, 1, 1, 1, 0}, OfIntegers(0, 4, 7).IntervalVector())
+	assert.Equal(t, [6]int{2, 5, 4, 3, 6, 1}, OfScale(scale.Of("C major")).IntervalVector())
+	assert.Equal(t, [6]int{0, 0, 4, 0, 0, 2}, OfIntegers(0, 3, 6, 9).IntervalVector())
+	assert.Equal(t, [6]int{}, OfIntegers(5).IntervalVector())
+}
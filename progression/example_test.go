@@ -0,0 +1,33 @@
+package progression_test
+
+import (
+	"fmt"
+
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/progression"
+)
+
+// Example demonstrates parsing a progression of chords with bar lines
+func Example() {
+	p := progression.Of("| C | Am7 | F G7 |")
+	for _, e := range p.Events {
+		fmt.Printf("%s at beat %v for %v beats\n", e.Chord.Name(), e.Position, e.Duration)
+	}
+
+	// Output:
+	// C at beat 0 for 4 beats
+	// Am7 at beat 4 for 4 beats
+	// F at beat 8 for 2 beats
+	// G7 at beat 10 for 2 beats
+}
+
+// ExampleParseIn demonstrates parsing a progression of Roman numerals in a key, and transposing it to another key
+func ExampleParseIn() {
+	p, _ := progression.ParseIn(key.Of("Eb major"), "| ii7 | V7/V | bVII | I6/4 |")
+	fmt.Println(p)
+	fmt.Println(p.Transpose(-3))
+
+	// Output:
+	// | Fm7 | F7 | Db | Eb/Bb |
+	// | Dm7 | D7 | Bb | C/G |
+}
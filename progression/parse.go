@@ -0,0 +1,186 @@
+// Progressions are parsed from chord names with bar lines, e.g. "| C | Am7 | F G7 |", in which each bar is divided equally among its chords.
+package progression
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// String of the progression as chord names with bar lines, e.g. "| C | Am7 | F G7 |".
+// A bar which is not divided equally among its chords is written one token per beat, e.g. "| C / / G |".
+func (this Progression) String() string {
+	beatsPerBar := this.beatsPerBar()
+	var bars []string
+	for start := 0.0; start < this.Beats(); start += beatsPerBar {
+		var events []Event
+		for _, e := range this.Events {
+			if e.Position >= start && e.Position < start+beatsPerBar {
+				events = append(events, e)
+			}
+		}
+		var tokens []string
+		if isEvenlyDivided(events, beatsPerBar) {
+			for _, e := range events {
+				tokens = append(tokens, e.Chord.Name())
+			}
+		} else {
+			for beat := start; beat < start+beatsPerBar; beat++ {
+				token := continueBeat
+				for _, e := range events {
+					if e.Position == beat {
+						token = e.Chord.Name()
+					}
+				}
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			tokens = append(tokens, continueBeat)
+		}
+		bars = append(bars, strings.Join(tokens, " "))
+	}
+	if len(bars) == 0 {
+		return ""
+	}
+	return barLine + " " + strings.Join(bars, " "+barLine+" ") + " " + barLine
+}
+
+//
+// Private
+//
+
+const (
+	barLine      = "|"
+	continueBeat = "/" // continues the previous chord for another beat, e.g. "| C / / G |"
+	repeatBar    = "%" // repeats the previous bar, e.g. "| C | % |"
+)
+
+// beatsPerBar of the progression, else the default
+func (this Progression) beatsPerBar() float64 {
+	if this.BeatsPerBar > 0 {
+		return this.BeatsPerBar
+	}
+	return DefaultBeatsPerBar
+}
+
+// isEvenlyDivided is true if the events begin with the bar and divide it equally
+func isEvenlyDivided(events []Event, beatsPerBar float64) bool {
+	for i, e := range events {
+		if e.Duration != beatsPerBar/float64(len(events)) || e.Position != events[0].Position+float64(i)*e.Duration {
+			return false
+		}
+	}
+	return len(events) > 0 && math.Mod(events[0].Position, beatsPerBar) == 0
+}
+
+// token of the text, with its byte offset
+type token struct {
+	text   string
+	offset int
+}
+
+// parse the progression from chord names (or Roman numerals, in a key) with bar lines, returning a *note.ParseError for the first chord that is not understood
+func parse(k key.Key, text string) (p Progression, err error) {
+	p.Key = k
+	p.BeatsPerBar = DefaultBeatsPerBar
+
+	var previous []token
+	position := 0.0
+	for _, bar := range barsOf(text) {
+		if len(bar) == 1 && bar[0].text == repeatBar {
+			bar = previous
+		}
+		if len(bar) == 0 {
+			continue
+		}
+		previous = bar
+
+		// a bar with continued beats has one beat per token, else it is divided equally among its chords
+		beats := p.BeatsPerBar / float64(len(bar))
+		if hasContinuedBeats(bar) {
+			beats = 1
+		}
+		for _, t := range bar {
+			if t.text == continueBeat {
+				if len(p.Events) > 0 {
+					p.Events[len(p.Events)-1].Duration += beats
+				}
+				position += beats
+				continue
+			}
+			c, parseErr := chordOf(k, t.text)
+			if parseErr != nil {
+				if err == nil {
+					err = parseErr.Within(text, t.offset)
+				}
+			}
+			p.Events = append(p.Events, Event{Chord: c, Position: position, Duration: beats})
+			position += beats
+		}
+		if hasContinuedBeats(bar) && float64(len(bar)) < p.BeatsPerBar && len(p.Events) > 0 {
+			p.Events[len(p.Events)-1].Duration += p.BeatsPerBar - float64(len(bar))
+			position += p.BeatsPerBar - float64(len(bar))
+		}
+	}
+	return
+}
+
+// chordOf a name, or a Roman numeral in the key if there is one
+func chordOf(k key.Key, name string) (chord.Chord, *note.ParseError) {
+	if k.Root != note.Nil {
+		if c, err := k.Chord(name); err == nil {
+			return c, nil
+		}
+	}
+	c, err := chord.Parse(name)
+	if err != nil {
+		return chord.Of(name), err.(*note.ParseError)
+	}
+	return c, nil
+}
+
+// hasContinuedBeats is true if any token of the bar continues the previous chord
+func hasContinuedBeats(bar []token) bool {
+	for _, t := range bar {
+		if t.text == continueBeat {
+			return true
+		}
+	}
+	return false
+}
+
+// barsOf the text, separated by bar lines, each as its tokens separated by spaces; text without any bar lines is one bar per chord
+func barsOf(text string) (bars [][]token) {
+	var bar []token
+	start := -1
+	for i, r := range text {
+		separator := unicode.IsSpace(r) || string(r) == barLine
+		if separator && start >= 0 {
+			bar = append(bar, token{text: text[start:i], offset: start})
+			start = -1
+		} else if !separator && start < 0 {
+			start = i
+		}
+		if string(r) == barLine {
+			bars = append(bars, bar)
+			bar = nil
+		}
+	}
+	if start >= 0 {
+		bar = append(bar, token{text: text[start:], offset: start})
+	}
+	bars = append(bars, bar)
+
+	if !strings.Contains(text, barLine) {
+		bars = nil
+		for _, t := range bar {
+			bars = append(bars, []token{t})
+		}
+	}
+	return
+}
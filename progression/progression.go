@@ -0,0 +1,136 @@
+// A chord progression is a succession of chords, each sounding at a position and for a duration in beats, optionally in a key.
+//
+// https://en.wikipedia.org/wiki/Chord_progression
+//
+// # Credit
+//
+// Charney Kaye
+// <hi@charneykaye.com>
+// https://charneykaye.com
+//
+// XJ Music
+// https://xj.io
+package progression
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Progression of chords, each at a position and for a duration in beats, optionally in a Key
+type Progression struct {
+	Key         key.Key // Key of the progression, or the zero Key if none
+	BeatsPerBar float64 // Beats in each bar, e.g. 4
+	Events      []Event // Chords in order of position
+}
+
+// Event of a Chord sounding at a Position for a Duration, both in beats
+type Event struct {
+	Chord    chord.Chord
+	Position float64 // Beats from the beginning of the progression
+	Duration float64 // Beats for which the chord sounds
+}
+
+// DefaultBeatsPerBar of a progression, i.e. common time
+const DefaultBeatsPerBar = 4.0
+
+// Of a progression of chord names with bar lines, e.g. Of("| C | Am7 | F G7 |")
+func Of(text string) Progression {
+	p, _ := parse(key.Key{}, text)
+	return p
+}
+
+// Parse a progression strictly, e.g. Parse("| C | Am7 | F G7 |"), returning the zero Progression and a *note.ParseError if any chord name is not understood
+func Parse(text string) (Progression, error) {
+	return ParseIn(key.Key{}, text)
+}
+
+// ParseIn a key, in which chords may also be named by Roman numeral, e.g. ParseIn(key.Of("G"), "| I | vi | IV V7 |"), returning the zero Progression and a *note.ParseError if any chord is not understood
+func ParseIn(k key.Key, text string) (Progression, error) {
+	p, err := parse(k, text)
+	if err != nil {
+		return Progression{}, err
+	}
+	return p, nil
+}
+
+// Beats in total, from the beginning of the progression to the end of its last chord
+func (this Progression) Beats() (beats float64) {
+	for _, e := range this.Events {
+		if e.Position+e.Duration > beats {
+			beats = e.Position + e.Duration
+		}
+	}
+	return
+}
+
+// At a position in beats, the Event sounding, or nil if none
+func (this Progression) At(position float64) *Event {
+	for i, e := range this.Events {
+		if position >= e.Position && position < e.Position+e.Duration {
+			return &this.Events[i]
+		}
+	}
+	return nil
+}
+
+// Transpose the progression (all its chords and its key) +/- semitones
+func (this Progression) Transpose(semitones int) Progression {
+	transposed := Progression{
+		Key:         this.Key,
+		BeatsPerBar: this.BeatsPerBar,
+	}
+	if this.Key.Root != note.Nil {
+		transposed.Key.Root, _ = this.Key.Root.Step(semitones)
+	}
+	for _, e := range this.Events {
+		transposed.Events = append(transposed.Events, Event{
+			Chord:    e.Chord.Transpose(semitones),
+			Position: e.Position,
+			Duration: e.Duration,
+		})
+	}
+	return transposed
+}
+
+// WithBeatsPerBar returns the progression in bars of the given number of beats, e.g. 3 for waltz time, scaling the position and duration of every chord
+func (this Progression) WithBeatsPerBar(beats float64) Progression {
+	if beats <= 0 {
+		return this
+	}
+	scale := beats / this.beatsPerBar()
+	scaled := Progression{
+		Key:         this.Key,
+		BeatsPerBar: beats,
+	}
+	for _, e := range this.Events {
+		scaled.Events = append(scaled.Events, Event{
+			Chord:    e.Chord,
+			Position: e.Position * scale,
+			Duration: e.Duration * scale,
+		})
+	}
+	return scaled
+}
+
+// InKey returns the progression in the given key, e.g. for the analysis of its chords by Roman numeral
+func (this Progression) InKey(k key.Key) Progression {
+	this.Key = k
+	return this
+}
+
+// Notes of every chord of the progression, voiced under the given Voicing constraints, each note at the position and for the duration of its chord, e.g. to be written as MIDI
+func (this Progression) Notes(v chord.Voicing) (notes []*note.Note, err error) {
+	for _, e := range this.Events {
+		voiced, err := e.Chord.Voice(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range voiced {
+			n.Position, n.Duration = e.Position, e.Duration
+			notes = append(notes, n)
+		}
+	}
+	return
+}
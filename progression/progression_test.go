@@ -0,0 +1,120 @@
+// A chord progression is a succession of chords, each sounding at a position and for a duration in beats, optionally in a key.
+package progression
+
+import (
+	"strconv"
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/key"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestOf(t *testing.T) {
+	p := Of("| C | Am7 | F G7 |")
+	assert.Equal(t, 4.0, p.BeatsPerBar)
+	assertEvents(t, p, "C@0+4", "Am7@4+4", "F@8+2", "G7@10+2")
+	assert.Equal(t, 12.0, p.Beats())
+}
+
+func TestOf_ContinuedBeats(t *testing.T) {
+	assertEvents(t, Of("| C / / G | Dm7 / G7 / |"), "C@0+3", "G@3+1", "Dm7@4+2", "G7@6+2")
+	assertEvents(t, Of("| C / | F |"), "C@0+4", "F@4+4")
+}
+
+func TestOf_RepeatBar(t *testing.T) {
+	assertEvents(t, Of("| C G | % | F |"), "C@0+2", "G@2+2", "C@4+2", "G@6+2", "F@8+4")
+}
+
+func TestOf_WithoutBarLines(t *testing.T) {
+	assertEvents(t, Of("C Am F G"), "C@0+4", "Am@4+4", "F@8+4", "G@12+4")
+}
+
+func TestOf_EmptyBars(t *testing.T) {
+	assertEvents(t, Of("|| C || G ||"), "C@0+4", "G@4+4")
+	assert.Equal(t, 0, len(Of("").Events))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	p, err := Parse("| C | Am7 | F X7 |")
+	assert.Equal(t, &note.ParseError{Name: "| C | Am7 | F X7 |", Part: note.RootPart, Offset: 14}, err)
+	assert.Equal(t, Progression{}, p)
+	p, err = Parse("| C | Cm7/Q |")
+	assert.Equal(t, &note.ParseError{Name: "| C | Cm7/Q |", Part: note.BassPart, Offset: 10}, err)
+	assert.Equal(t, Progression{}, p)
+	p, err = ParseIn(key.Of("G"), "| I | vi | IV Q |")
+	assert.Equal(t, &note.ParseError{Name: "| I | vi | IV Q |", Part: note.RootPart, Offset: 14}, err)
+	assert.Equal(t, Progression{}, p)
+	assert.Equal(t, 4, len(Of("| C | Am7 | F X7 |").Events)) // leniently, best effort
+}
+
+func TestParseIn(t *testing.T) {
+	p, err := ParseIn(key.Of("G"), "| I | vi | IV V7 |")
+	assert.Nil(t, err)
+	assertEvents(t, p, "G@0+4", "Em@4+4", "C@8+2", "D7@10+2")
+	assert.Equal(t, note.G, p.Key.Root)
+}
+
+func TestAt(t *testing.T) {
+	p := Of("| C | Am7 | F G7 |")
+	assert.Equal(t, "Am7", p.At(5).Chord.Name())
+	assert.Equal(t, "G7", p.At(11.5).Chord.Name())
+	assert.Nil(t, p.At(12))
+}
+
+func TestTranspose(t *testing.T) {
+	p := Of("| C | Am7 | F G7/B |").InKey(key.Of("C")).Transpose(2)
+	assertEvents(t, p, "D@0+4", "Bm7@4+4", "G@8+2", "A7/C#@10+2")
+	assert.Equal(t, note.D, p.Key.Root)
+}
+
+func TestWithBeatsPerBar(t *testing.T) {
+	p := Of("| C | F G7 |").WithBeatsPerBar(3)
+	assert.Equal(t, 3.0, p.BeatsPerBar)
+	assertEvents(t, p, "C@0+3", "F@3+1.5", "G7@4.5+1.5")
+	assert.Equal(t, "| C | F G7 |", p.String())
+}
+
+func TestNotes(t *testing.T) {
+	notes, err := Of("| C | F G7 |").Notes(chord.Voicing{})
+	assert.Nil(t, err)
+	var actual []string
+	for _, n := range notes {
+		actual = append(actual, n.Class.String(note.Sharp)+strconv.Itoa(int(n.Octave))+"@"+formatBeats(n.Position)+"+"+formatBeats(n.Duration))
+	}
+	assert.Equal(t, []string{
+		"C4@0+4", "E4@0+4", "G4@0+4",
+		"F4@4+2", "A4@4+2", "C5@4+2",
+		"G3@6+2", "B3@6+2", "D4@6+2", "F4@6+2",
+	}, actual)
+}
+
+func TestNotes_Unvoiceable(t *testing.T) {
+	_, err := Of("| C | G7 |").Notes(chord.Voicing{Inversion: 5})
+	assert.Equal(t, chord.ErrInversion, err)
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "| C | Am7 | F G7 |", Of("| C | Am7 | F G7 |").String())
+	assert.Equal(t, "| C / / G | Dm7 G7 |", Of("| C / / G | Dm7 / G7 / |").String())
+	assert.Equal(t, "| D | Bm7 | G A7 |", Of("| C | Am7 | F G7 |").Transpose(2).String())
+	assert.Equal(t, "", Of("").String())
+}
+
+//
+// Private
+//
+
+func assertEvents(t *testing.T, p Progression, expect ...string) {
+	var actual []string
+	for _, e := range p.Events {
+		actual = append(actual, e.Chord.Name()+"@"+formatBeats(e.Position)+"+"+formatBeats(e.Duration))
+	}
+	assert.Equal(t, expect, actual)
+}
+
+func formatBeats(beats float64) string {
+	return strconv.FormatFloat(beats, 'f', -1, 64)
+}
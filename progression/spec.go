@@ -0,0 +1,61 @@
+// Progressions are expressed in readable strings, e.g. | C | Am7 | F G7 |
+package progression
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func (p Progression) ToYAML() string {
+	spec := specFrom(p)
+	out, _ := yaml.Marshal(spec)
+	return string(out[:])
+}
+
+func (p Progression) ToJSON() string {
+	spec := specFrom(p)
+	out, _ := json.MarshalIndent(spec, "", "  ")
+	return string(out[:]) + "\n"
+}
+
+//
+// Private
+//
+
+func specFrom(p Progression) specProgression {
+	s := specProgression{}
+	if p.Key.Root != note.Nil {
+		s.Key = p.Key.Root.Spelled(p.Key.AdjSymbol).String() + " " + p.Key.Mode.String()
+	}
+	s.BeatsPerBar = p.beatsPerBar()
+	s.Beats = p.Beats()
+	for _, e := range p.Events {
+		c := specEvent{
+			Name:     e.Chord.Name(),
+			Position: e.Position,
+			Duration: e.Duration,
+		}
+		if p.Key.Root != note.Nil {
+			c.Numeral = p.Key.Analyze(e.Chord).Numeral
+		}
+		s.Chords = append(s.Chords, c)
+	}
+	return s
+}
+
+type specProgression struct {
+	Key         string      `yaml:"key,omitempty" json:"key,omitempty"`
+	BeatsPerBar float64     `yaml:"beatsPerBar" json:"beatsPerBar"`
+	Beats       float64     `yaml:"beats" json:"beats"`
+	Chords      []specEvent `yaml:"chords" json:"chords"`
+}
+
+type specEvent struct {
+	Name     string  `yaml:"name" json:"name"`
+	Numeral  string  `yaml:"numeral,omitempty" json:"numeral,omitempty"`
+	Position float64 `yaml:"position" json:"position"`
+	Duration float64 `yaml:"duration" json:"duration"`
+}
@@ -0,0 +1,57 @@
+// Progressions are expressed in readable strings, e.g. | C | Am7 | F G7 |
+package progression
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/key"
+)
+
+func TestToYAML(t *testing.T) {
+	assert.Equal(t, `beatsPerBar: 4
+beats: 8
+chords:
+- name: C
+  position: 0
+  duration: 4
+- name: F
+  position: 4
+  duration: 2
+- name: G7
+  position: 6
+  duration: 2
+`, Of("| C | F G7 |").ToYAML())
+}
+
+func TestToYAML_Key(t *testing.T) {
+	assert.Equal(t, `key: Eb Major
+beatsPerBar: 4
+beats: 4
+chords:
+- name: Fm7
+  numeral: ii7
+  position: 0
+  duration: 2
+- name: Bb7
+  numeral: V7
+  position: 2
+  duration: 2
+`, Of("| Fm7 Bb7 |").InKey(key.Of("Eb")).ToYAML())
+}
+
+func TestToJSON(t *testing.T) {
+	assert.Equal(t, `{
+  "beatsPerBar": 4,
+  "beats": 4,
+  "chords": [
+    {
+      "name": "C",
+      "position": 0,
+      "duration": 4
+    }
+  ]
+}
+`, Of("| C |").ToJSON())
+}
@@ -0,0 +1,173 @@
+// Chord-scales are the scales that fit a chord, containing all its tones, e.g. G7#11 fits G Lydian Dominant; the other tones of each scale are available tensions or avoid notes.
+package scale
+
+import (
+	"sort"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Role of a tone of a chord-scale, relative to the chord it fits
+type Role int
+
+const (
+	ChordToneRole Role = iota // Tone of the chord
+	TensionRole               // Available tension, e.g. the 9th, #11th or 13th, which may be added to the chord
+	AvoidRole                 // Avoid note, a half step above a chord tone, e.g. the 11th over a major chord
+)
+
+// ChordScale is a Scale that fits a chord, with the Role of each of its tones
+type ChordScale struct {
+	Scale Scale
+	Mode  string            // Name of the Mode of the Scale, e.g. "Lydian Dominant"
+	Roles map[Interval]Role // Role of each tone of the Scale
+}
+
+// ChordScales that fit the given chord, rooted on its root and containing all its tones (and its slash bass), fewest avoid notes first.
+// Every Mode is considered, so each scale added to the modes is also matched to chords; modes with the same tones as an earlier mode are listed only once.
+// A tone of the scale that is not in the chord is an avoid note if it is a half step above a chord tone, except the b9 of a dominant chord, which is an available tension.
+func ChordScales(c chord.Chord) (fits []ChordScale) {
+	if !c.Root.IsChromatic() {
+		return
+	}
+	in := make(map[note.Class]bool)
+	for _, class := range c.Tones {
+		in[class] = true
+	}
+	if c.Bass != note.Nil {
+		in[c.Bass] = true
+	}
+	dominant := isDominant(c)
+
+	seen := make(map[string]bool)
+	for _, m := range modes {
+		if m.pos == nil {
+			continue // the default mode is the same as Major
+		}
+		s := m.scaleOf(c.Root, c.AdjSymbol)
+		key := s.toneKey()
+		if seen[key] || !s.containsAll(in) {
+			continue
+		}
+		seen[key] = true
+		fit := ChordScale{Scale: s, Mode: m.Name, Roles: make(map[Interval]Role)}
+		for i, class := range s.Tones {
+			switch {
+			case in[class]:
+				fit.Roles[i] = ChordToneRole
+			case in[stepped(class, -1)] && !(dominant && semitonesAbove(c.Root, class) == 1):
+				fit.Roles[i] = AvoidRole
+			default:
+				fit.Roles[i] = TensionRole
+			}
+		}
+		fits = append(fits, fit)
+	}
+
+	sort.SliceStable(fits, func(i, j int) bool {
+		return len(fits[i].Avoid()) < len(fits[j].Avoid())
+	})
+	return
+}
+
+// Tensions available over the chord, in order of degree
+func (this ChordScale) Tensions() []note.Class {
+	return this.withRole(TensionRole)
+}
+
+// Avoid notes over the chord, in order of degree
+func (this ChordScale) Avoid() []note.Class {
+	return this.withRole(AvoidRole)
+}
+
+//
+// Private
+//
+
+// withRole are the tones of the chord-scale with the given Role, in order of degree
+func (this ChordScale) withRole(role Role) (classes []note.Class) {
+	for _, i := range intervalOrder {
+		if r, ok := this.Roles[i]; ok && r == role {
+			classes = append(classes, this.Scale.Tones[i])
+		}
+	}
+	return
+}
+
+// scaleOf the Mode alone, from the given root
+func (this Mode) scaleOf(root note.Class, adjSymbol note.AdjSymbol) Scale {
+	s := Scale{Root: root, AdjSymbol: adjSymbol, Tones: make(map[Interval]note.Class)}
+	for _, i := range s.applyMode(this) {
+		delete(s.Tones, i)
+	}
+	return s
+}
+
+// toneKey of the distinct tones of the Scale, ascending and descending, identical for any two scales with the same tones
+func (this Scale) toneKey() string {
+	var present [note.B + 1]bool
+	microtonal := ""
+	for _, class := range this.Tones {
+		if class.IsChromatic() {
+			present[class] = true
+		} else {
+			microtonal += class.String(this.AdjSymbol)
+		}
+	}
+	key := ""
+	for class := note.C; class <= note.B; class++ {
+		if present[class] {
+			key += class.String(note.Sharp)
+		}
+	}
+	if this.Descend != nil {
+		key += "/" + this.inDirection(DescendDirection).toneKey()
+	}
+	return key + microtonal
+}
+
+// containsAll of the given classes
+func (this Scale) containsAll(classes map[note.Class]bool) bool {
+	in := make(map[note.Class]bool)
+	for _, class := range this.Tones {
+		in[class] = true
+	}
+	for class := range classes {
+		if !in[class] {
+			return false
+		}
+	}
+	return true
+}
+
+// isDominant is true for a chord with a major third and minor seventh
+func isDominant(c chord.Chord) bool {
+	var third, seventh bool
+	for _, class := range c.Tones {
+		if !class.IsChromatic() {
+			continue
+		}
+		switch semitonesAbove(c.Root, class) {
+		case 4:
+			third = true
+		case 10:
+			seventh = true
+		}
+	}
+	return third && seventh
+}
+
+// stepped class some semitones from the given class
+func stepped(class note.Class, semitones int) note.Class {
+	s, _ := class.Step(semitones)
+	return s
+}
+
+// semitonesAbove the given class, from 0 to 11
+func semitonesAbove(from note.Class, to note.Class) int {
+	if !from.IsChromatic() || !to.IsChromatic() {
+		return -1
+	}
+	return (from.Diff(to) + 12) % 12
+}
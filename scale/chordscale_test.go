@@ -0,0 +1,82 @@
+// Chord-scales are the scales that fit a chord, containing all its tones, e.g. G7#11 fits G Lydian Dominant; the other tones of each scale are available tensions or avoid notes.
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestChordScales(t *testing.T) {
+	fits := ChordScales(chord.Of("Cmaj7"))
+	assert.Equal(t, "Lydian", fits[0].Mode)
+	assert.Equal(t, []note.Class{note.D, note.Fs, note.A}, fits[0].Tensions())
+	assert.Equal(t, 0, len(fits[0].Avoid()))
+	major := fitNamed(fits, "Major")
+	assert.Equal(t, []note.Class{note.D, note.A}, major.Tensions())
+	assert.Equal(t, []note.Class{note.F}, major.Avoid())
+	assert.NotContains(t, modeNames(fits), "Dorian")
+}
+
+func TestChordScales_Minor(t *testing.T) {
+	fits := ChordScales(chord.Of("Dm7"))
+	assert.Equal(t, "Dorian", fits[0].Mode)
+	assert.Equal(t, []note.Class{note.E, note.G, note.B}, fits[0].Tensions())
+	assert.Equal(t, ChordToneRole, fits[0].Roles[I1])
+	assert.Equal(t, TensionRole, fits[0].Roles[I2])
+	assert.Contains(t, modeNames(fits), "Minor")
+	assert.Contains(t, modeNames(fits), "Phrygian")
+	assert.NotContains(t, modeNames(fits), "Aeolian") // same tones as Minor
+}
+
+func TestChordScales_Dominant(t *testing.T) {
+	fits := ChordScales(chord.Of("G7"))
+	assert.Equal(t, "Lydian Dominant", fits[0].Mode)
+	assert.Equal(t, []note.Class{note.C}, fitNamed(fits, "Mixolydian").Avoid())
+	assert.Equal(t, []note.Class{note.C, note.Ds}, fitNamed(fits, "Phrygian Dominant").Avoid()) // the b9 of a dominant chord is a tension
+	for _, fit := range fits {
+		for _, class := range chord.Of("G7").Tones {
+			assert.True(t, containsClass(fit.Scale, class), fit.Mode)
+		}
+	}
+}
+
+func TestChordScales_SharpEleven(t *testing.T) {
+	fits := ChordScales(chord.Of("G7#11"))
+	assert.Equal(t, "Lydian Dominant", fits[0].Mode)
+	assert.Equal(t, []note.Class{note.A, note.E}, fits[0].Tensions())
+	assert.Contains(t, modeNames(fits), "Octatonic Half-Whole")
+	assert.NotContains(t, modeNames(fits), "Mixolydian")
+}
+
+func TestChordScales_SlashBass(t *testing.T) {
+	assert.NotContains(t, modeNames(ChordScales(chord.Of("C/F#"))), "Major")
+	assert.Contains(t, modeNames(ChordScales(chord.Of("C/F#"))), "Lydian")
+}
+
+func TestChordScales_Nil(t *testing.T) {
+	assert.Equal(t, 0, len(ChordScales(chord.Chord{})))
+}
+
+//
+// Private
+//
+
+func modeNames(fits []ChordScale) (names []string) {
+	for _, fit := range fits {
+		names = append(names, fit.Mode)
+	}
+	return
+}
+
+func fitNamed(fits []ChordScale, mode string) ChordScale {
+	for _, fit := range fits {
+		if fit.Mode == mode {
+			return fit
+		}
+	}
+	return ChordScale{}
+}
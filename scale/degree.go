@@ -0,0 +1,122 @@
+// Scale degrees are counted from the root of the scale as degree 1, e.g. in C major degree 5 is G, and a note steps diatonically along the scale from one degree to the next.
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Degree n of the ascending Scale, counting the root as degree 1 and wrapping around into higher octaves (or lower, for n < 1), plus the octave above (or below) the root's octave in which that tone lies, e.g. in A minor, degree 3 is C and degree 9 is B, both one octave up.
+// Returns note.Nil for a Scale without tones.
+func (this Scale) Degree(n int) (note.Class, note.Octave) {
+	_, tones := this.ordered()
+	if len(tones) == 0 || !this.Root.IsChromatic() {
+		return note.Nil, 0
+	}
+	index := n - 1
+	octaves := floorDiv(index, len(tones))
+	index -= octaves * len(tones)
+	class, octave := this.Root.Step(semitonesAbove(this.Root, tones[index]))
+	return class, octave + note.Octave(octaves)
+}
+
+// DegreeOf the given class in the ascending Scale, plus its chromatic alteration from the tone of that degree, e.g. in C major, G is degree 5 with no alteration and F# is degree 4 raised by +1.
+// In a seven-tone scale, an altered class is the degree with the same letter name, as spelled by the Scale's Sharps or Flats; in any other scale, it is the nearest degree below.
+// Returns degree 0 if the class is not chromatic or the Scale has no tones.
+func (this Scale) DegreeOf(class note.Class) (degree int, alteration int) {
+	degrees, tones := this.ordered()
+	if !class.IsChromatic() || len(tones) == 0 {
+		return 0, 0
+	}
+	for i, tone := range tones {
+		if tone == class {
+			return i + 1, 0
+		}
+	}
+
+	if isHeptatonic(this.Tones) {
+		spelled := this.Spelled()
+		letter := class.Spelled(this.AdjSymbol).Letter
+		for i, d := range degrees {
+			if spelled[d].Letter == letter {
+				return i + 1, tones[i].Diff(class)
+			}
+		}
+	}
+
+	for i, tone := range tones {
+		if !tone.IsChromatic() {
+			continue
+		}
+		if up := semitonesAbove(tone, class); degree == 0 || up < alteration {
+			degree, alteration = i+1, up
+		}
+	}
+	return
+}
+
+// Step the given note k degrees along the Scale, up for k > 0 or down for k < 0, across octaves, e.g. in C major, stepping B4 up by 1 is C5 and stepping E4 down by 3 is B3.
+// A Scale with different tones descending steps up by its Tones and down by its Descend tones, e.g. in C melodic minor, G4 steps up to A4 and down from C5 to Bb4.
+// A note that is not in the Scale steps from the degree it alters, e.g. in C major, F#4 stepped up by 1 is G4.
+// Returns a copy of the note, unchanged, if it is not chromatic or the Scale has no tones.
+func (this Scale) Step(n *note.Note, k int) *note.Note {
+	if k < 0 {
+		this = this.inDirection(DescendDirection)
+	}
+	stepped := *n
+	_, tones := this.ordered()
+	degree, alteration := this.DegreeOf(n.Class)
+	if degree == 0 {
+		return &stepped
+	}
+
+	// from the tone of the degree which the note alters
+	class, octave := n.Class.Step(-alteration)
+	stepped.Class, stepped.Octave = class, n.Octave+octave
+
+	semitones := 0
+	index := degree - 1
+	for ; k > 0; k-- {
+		next := (index + 1) % len(tones)
+		semitones += nonZeroSemitonesUp(tones[index], tones[next])
+		index = next
+	}
+	for ; k < 0; k++ {
+		previous := (index - 1 + len(tones)) % len(tones)
+		semitones -= nonZeroSemitonesUp(tones[previous], tones[index])
+		index = previous
+	}
+	class, octave = stepped.Class.Step(semitones)
+	stepped.Class, stepped.Octave = class, stepped.Octave+octave
+	return &stepped
+}
+
+// Contains the given class, as one of the tones of the Scale
+func (this Scale) Contains(class note.Class) bool {
+	for _, tone := range this.Tones {
+		if tone == class {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// Private
+//
+
+// nonZeroSemitonesUp from one degree to the next, from 1 to 12
+func nonZeroSemitonesUp(from note.Class, to note.Class) int {
+	if s := semitonesAbove(from, to); s > 0 {
+		return s
+	}
+	return 12
+}
+
+// floorDiv rounds the quotient down, also for negative numbers
+func floorDiv(a int, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
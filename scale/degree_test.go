@@ -0,0 +1,88 @@
+// Scale degrees are counted from the root of the scale as degree 1, e.g. in C major degree 5 is G, and a note steps diatonically along the scale from one degree to the next.
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestDegree(t *testing.T) {
+	assertDegree(t, note.C, 0, Of("C major"), 1)
+	assertDegree(t, note.G, 0, Of("C major"), 5)
+	assertDegree(t, note.B, 0, Of("C major"), 7)
+	assertDegree(t, note.C, 1, Of("C major"), 8)
+	assertDegree(t, note.D, 1, Of("C major"), 9)
+	assertDegree(t, note.D, 2, Of("C major"), 16)
+	assertDegree(t, note.B, -1, Of("C major"), 0)
+	assertDegree(t, note.C, -1, Of("C major"), -6)
+	assertDegree(t, note.C, 1, Of("A minor"), 3)
+	assertDegree(t, note.B, 1, Of("A minor"), 9)
+	assertDegree(t, note.A, 0, Of("C pentatonic"), 5)
+	assertDegree(t, note.C, 1, Of("C pentatonic"), 6)
+	assertDegree(t, note.Nil, 0, Scale{}, 1)
+}
+
+func TestDegreeOf(t *testing.T) {
+	assertDegreeOf(t, 1, 0, Of("C major"), note.C)
+	assertDegreeOf(t, 5, 0, Of("C major"), note.G)
+	assertDegreeOf(t, 4, 1, Of("C major"), note.Fs)
+	assertDegreeOf(t, 7, -1, Of("Bb major"), note.Gs)
+	assertDegreeOf(t, 3, 1, Of("C minor"), note.E)
+	assertDegreeOf(t, 3, 0, Of("Eb major"), note.G)
+	assertDegreeOf(t, 1, 1, Of("Eb major"), note.E)
+	assertDegreeOf(t, 3, 1, Of("C pentatonic"), note.F)
+	assertDegreeOf(t, 5, 2, Of("C pentatonic"), note.B)
+	assertDegreeOf(t, 0, 0, Of("C major"), note.Ch7)
+	assertDegreeOf(t, 0, 0, Scale{}, note.C)
+}
+
+func TestStep(t *testing.T) {
+	assertStep(t, "C5", Of("C major"), "B4", 1)
+	assertStep(t, "B3", Of("C major"), "E4", -3)
+	assertStep(t, "E5", Of("C major"), "C4", 9)
+	assertStep(t, "C4", Of("C major"), "C4", 0)
+	assertStep(t, "G4", Of("C major"), "F#4", 1)
+	assertStep(t, "E4", Of("C major"), "F#4", -1)
+	assertStep(t, "C5", Of("A minor"), "A4", 2)
+	assertStep(t, "G#3", Of("A harmonic minor"), "C4", -3)
+	assertStep(t, "C5", Of("C pentatonic"), "A4", 1)
+	assertStep(t, "A3", Of("C pentatonic"), "C4", -1)
+	assertStep(t, "C4", Of("C whole tone"), "A#3", 1)
+	assertStep(t, "C4", Scale{}, "C4", 3)
+}
+
+func TestStep_KeepsNote(t *testing.T) {
+	n := &note.Note{Class: note.E, Octave: 4, Performer: "flute", Position: 2, Duration: 0.5, Code: "x"}
+	stepped := Of("C major").Step(n, 1)
+	assert.Equal(t, &note.Note{Class: note.F, Octave: 4, Performer: "flute", Position: 2, Duration: 0.5, Code: "x"}, stepped)
+	assert.Equal(t, note.E, n.Class)
+}
+
+func TestContains(t *testing.T) {
+	assert.True(t, Of("D dorian").Contains(note.B))
+	assert.False(t, Of("D dorian").Contains(note.As))
+	assert.False(t, Scale{}.Contains(note.C))
+}
+
+//
+// Private
+//
+
+func assertDegree(t *testing.T, expectClass note.Class, expectOctave note.Octave, s Scale, n int) {
+	class, octave := s.Degree(n)
+	assert.Equal(t, expectClass, class)
+	assert.Equal(t, expectOctave, octave)
+}
+
+func assertDegreeOf(t *testing.T, expectDegree int, expectAlteration int, s Scale, class note.Class) {
+	degree, alteration := s.DegreeOf(class)
+	assert.Equal(t, expectDegree, degree)
+	assert.Equal(t, expectAlteration, alteration)
+}
+
+func assertStep(t *testing.T, expect string, s Scale, from string, k int) {
+	assert.Equal(t, note.Named(expect), s.Step(note.Named(from), k), from)
+}
@@ -0,0 +1,53 @@
+// Some scales contain different tones ascending than descending, e.g. the melodic minor scale ascends with a raised sixth and seventh and descends as the natural minor.
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// Direction in which a Scale is played
+type Direction int
+
+const (
+	AscendDirection Direction = iota
+	DescendDirection
+)
+
+// TonesIn the given Direction, which are the Descend tones of a Scale descending, if it has any, else its Tones
+func (this Scale) TonesIn(direction Direction) map[Interval]note.Class {
+	if direction == DescendDirection && this.Descend != nil {
+		return this.Descend
+	}
+	return this.Tones
+}
+
+// NotesIn the given Direction, in the order played: ascending from the root, or from the root descending, e.g. C melodic minor descending is C Bb Ab G F Eb D
+func (this *Scale) NotesIn(direction Direction) (notes []*note.Note) {
+	s := this.inDirection(direction)
+	if direction != DescendDirection {
+		return s.Notes()
+	}
+	_, tones := s.ordered()
+	for i := range tones {
+		notes = append(notes, note.OfClass(tones[(len(tones)-i)%len(tones)]))
+	}
+	return
+}
+
+// IsDirectional is true if the Scale has different tones descending than ascending
+func (this Scale) IsDirectional() bool {
+	return this.Descend != nil
+}
+
+//
+// Private
+//
+
+// inDirection is the Scale with only the tones of the given Direction
+func (this Scale) inDirection(direction Direction) Scale {
+	return Scale{
+		Root:      this.Root,
+		AdjSymbol: this.AdjSymbol,
+		Tones:     this.TonesIn(direction),
+	}
+}
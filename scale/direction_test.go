@@ -0,0 +1,61 @@
+// Some scales contain different tones ascending than descending, e.g. the melodic minor scale ascends with a raised sixth and seventh and descends as the natural minor.
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestTonesIn(t *testing.T) {
+	s := Of("C melodic minor")
+	assert.True(t, s.IsDirectional())
+	assert.Equal(t, note.A, s.TonesIn(AscendDirection)[I6])
+	assert.Equal(t, note.Gs, s.TonesIn(DescendDirection)[I6])
+	assert.False(t, Of("C major").IsDirectional())
+	assert.Equal(t, Of("C major").Tones, Of("C major").TonesIn(DescendDirection))
+}
+
+func TestNotesIn(t *testing.T) {
+	s := Of("C melodic minor")
+	assert.Equal(t, []*note.Note{
+		{Class: note.C},
+		{Class: note.D},
+		{Class: note.Ds},
+		{Class: note.F},
+		{Class: note.G},
+		{Class: note.A},
+		{Class: note.B},
+	}, s.NotesIn(AscendDirection))
+	assert.Equal(t, []*note.Note{
+		{Class: note.C},
+		{Class: note.As},
+		{Class: note.Gs},
+		{Class: note.G},
+		{Class: note.F},
+		{Class: note.Ds},
+		{Class: note.D},
+	}, s.NotesIn(DescendDirection))
+	assert.Equal(t, s.Notes(), s.NotesIn(AscendDirection))
+	empty := Scale{}
+	assert.Equal(t, 0, len(empty.NotesIn(DescendDirection)))
+}
+
+func TestStep_Directional(t *testing.T) {
+	s := Of("C melodic minor")
+	assertStep(t, "A4", s, "G4", 1)
+	assertStep(t, "B4", s, "G4", 2)
+	assertStep(t, "A#4", s, "C5", -1)
+	assertStep(t, "G#4", s, "C5", -2)
+	assertStep(t, "G4", s, "A4", -1) // A is only in the ascending scale, so descends from the degree it alters
+	bebop := Of("C bebop dominant")
+	assertStep(t, "C5", bebop, "A#4", 1)
+	assertStep(t, "B4", bebop, "C5", -1)
+	assertStep(t, "A#4", bebop, "C5", -2)
+}
+
+func TestToYAML_Directional(t *testing.T) {
+	assert.Equal(t, "root: C\ntones:\n  1: C\n  2: D\n  3: Eb\n  4: F\n  5: G\n  6: A\n  7: B\ndescend:\n  1: C\n  2: D\n  3: Eb\n  4: F\n  5: G\n  6: Ab\n  7: Bb\n", Of("C melodic minor").ToYAML())
+}
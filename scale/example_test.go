@@ -2,7 +2,9 @@ package scale_test
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/go-music-theory/music-theory/chord"
 	"github.com/go-music-theory/music-theory/note"
 	"github.com/go-music-theory/music-theory/scale"
 )
@@ -52,3 +54,84 @@ func ExampleScale_Notes() {
 
 	// Output: C, D, E, F, G, A, B
 }
+
+// ExampleScale_Spelled demonstrates spelling a scale with a distinct letter name for each degree
+func ExampleScale_Spelled() {
+	s := scale.Of("C# major")
+	spelled := s.Spelled()
+
+	for i := scale.I1; i <= scale.I7; i++ {
+		if i > scale.I1 {
+			fmt.Print(", ")
+		}
+		fmt.Print(spelled[i])
+	}
+	fmt.Println()
+
+	// Output: C#, D#, E#, F#, G#, A#, B#
+}
+
+// ExampleScale_Sevenths demonstrates harmonizing every degree of a scale with a seventh chord
+func ExampleScale_Sevenths() {
+	for _, dc := range scale.Of("D dorian").Sevenths() {
+		fmt.Printf("%d: %s\n", dc.Degree, dc.Chord.Name())
+	}
+	// Output:
+	// 1: Dm7
+	// 2: Em7
+	// 3: Fmaj7
+	// 4: G7
+	// 5: Am7
+	// 6: Bm7b5
+	// 7: Cmaj7
+}
+
+// ExampleChordScales demonstrates finding the scales that fit a chord, with their tensions and avoid notes
+func ExampleChordScales() {
+	for _, fit := range scale.ChordScales(chord.Of("G7#11")) {
+		fmt.Printf("%s: tensions %v avoid %v\n", fit.Mode, names(fit.Tensions()), names(fit.Avoid()))
+	}
+	// Output:
+	// Lydian Dominant: tensions [A E] avoid []
+	// Octatonic Half-Whole: tensions [G# A# E] avoid []
+	// Hungarian Major: tensions [A# E] avoid []
+}
+
+func names(classes []note.Class) (names []string) {
+	for _, class := range classes {
+		names = append(names, class.String(note.Sharp))
+	}
+	return
+}
+
+// ExampleScale_Step demonstrates stepping a note diatonically along a scale
+func ExampleScale_Step() {
+	s := scale.Of("G major")
+	n := note.Named("E4")
+	var names []string
+	for i := 0; i < 4; i++ {
+		n = s.Step(n, 1)
+		names = append(names, fmt.Sprintf("%s%d", n.Class.String(s.AdjSymbol), n.Octave))
+	}
+	fmt.Println(strings.Join(names, " "))
+	degree, alteration := s.DegreeOf(note.F)
+	fmt.Printf("F is degree %d altered %+d\n", degree, alteration)
+	// Output:
+	// F#4 G4 A4 B4
+	// F is degree 7 altered -1
+}
+
+// ExampleScale_NotesIn demonstrates a scale with different tones ascending than descending
+func ExampleScale_NotesIn() {
+	s := scale.Of("A melodic minor")
+	for _, direction := range []scale.Direction{scale.AscendDirection, scale.DescendDirection} {
+		var names []string
+		for _, n := range s.NotesIn(direction) {
+			names = append(names, n.Class.String(note.Sharp))
+		}
+		fmt.Println(strings.Join(names, " "))
+	}
+	// Output:
+	// A B C D E F# G#
+	// A G F E D C B
+}
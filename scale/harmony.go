@@ -0,0 +1,71 @@
+// Scales are harmonized by stacking thirds within the scale on each of its degrees, e.g. D dorian has the triads Dm Em F G Am Bdim C.
+package scale
+
+import (
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+// DegreeChord is the chord built on a Degree of a Scale, e.g. the ii chord is built on Degree 2
+type DegreeChord struct {
+	Degree Interval
+	Chord  chord.Chord
+}
+
+// Triads on every degree of the Scale, each stacking the third and fifth above it within the scale, in order of degree
+func (this Scale) Triads() []DegreeChord {
+	return this.Harmonize(3)
+}
+
+// Sevenths on every degree of the Scale, each stacking the third, fifth and seventh above it within the scale, in order of degree
+func (this Scale) Sevenths() []DegreeChord {
+	return this.Harmonize(4)
+}
+
+// Harmonize every degree of the Scale with a chord of the given number of tones (e.g. 3 for triads, 4 for sevenths, 5 for ninths) stacked in thirds within the scale, in order of degree.
+// A third within the scale is every other tone of the scale, so a scale with more or fewer than seven tones (e.g. a pentatonic scale) is harmonized by its own steps.
+func (this Scale) Harmonize(tones int) (chords []DegreeChord) {
+	degrees, ordered := this.ordered()
+	if len(ordered) == 0 || tones < 1 {
+		return
+	}
+	spelled := this.Spelled()
+	for d, root := range ordered {
+		c := chord.Chord{
+			Root:      root,
+			AdjSymbol: adjSymbolOfSpelled(spelled[degrees[d]], this.AdjSymbol),
+			Tones:     make(map[chord.Interval]note.Class),
+		}
+		for t := 0; t < tones; t++ {
+			c.Tones[chord.Interval(2*t+1)] = ordered[(d+2*t)%len(ordered)]
+		}
+		chords = append(chords, DegreeChord{Degree: degrees[d], Chord: c})
+	}
+	return
+}
+
+//
+// Private
+//
+
+// ordered degrees of the Scale and their tones, in order of degree
+func (this Scale) ordered() (degrees []Interval, tones []note.Class) {
+	for _, i := range intervalOrder {
+		if class, ok := this.Tones[i]; ok {
+			degrees = append(degrees, i)
+			tones = append(tones, class)
+		}
+	}
+	return
+}
+
+// adjSymbolOfSpelled tone, so that a chord built on it is named as the scale spells it, e.g. G# (not Ab) in A harmonic minor
+func adjSymbolOfSpelled(s note.Spelled, otherwise note.AdjSymbol) note.AdjSymbol {
+	switch {
+	case s.Accidental > 0:
+		return note.Sharp
+	case s.Accidental < 0:
+		return note.Flat
+	}
+	return otherwise
+}
@@ -0,0 +1,77 @@
+// Scales are harmonized by stacking thirds within the scale on each of its degrees, e.g. D dorian has the triads Dm Em F G Am Bdim C.
+package scale
+
+import (
+	"testing"
+
+	"gopkg.in/stretchr/testify.v1/assert"
+
+	"github.com/go-music-theory/music-theory/chord"
+	"github.com/go-music-theory/music-theory/note"
+)
+
+func TestTriads(t *testing.T) {
+	assertHarmony(t, []string{"Dm", "Em", "F", "G", "Am", "Bdim", "C"}, Of("D dorian").Triads())
+	assertHarmony(t, []string{"C", "Dm", "Em", "F", "G", "Am", "Bdim"}, Of("C major").Triads())
+	assertHarmony(t, []string{"Am", "Bdim", "Caug", "Dm", "E", "F", "G#dim"}, Of("A harmonic minor").Triads())
+}
+
+func TestSevenths(t *testing.T) {
+	assertHarmony(t, []string{"Cmaj7", "Dm7", "Em7", "Fmaj7", "G7", "Am7", "Bm7b5"}, Of("C major").Sevenths())
+	assertHarmony(t, []string{"Dm7", "Em7", "Fmaj7", "G7", "Am7", "Bm7b5", "Cmaj7"}, Of("D dorian").Sevenths())
+	assertHarmony(t, []string{"Ebmaj7", "Fm7", "Gm7", "Abmaj7", "Bb7", "Cm7", "Dm7b5"}, Of("Eb major").Sevenths())
+}
+
+func TestHarmonize(t *testing.T) {
+	chords := Of("C major").Harmonize(5)
+	assert.Equal(t, 7, len(chords))
+	assert.Equal(t, "Cmaj9", chords[0].Chord.Name())
+	assert.Equal(t, "G9", chords[4].Chord.Name())
+	assert.Equal(t, 0, len(Of("C").Harmonize(0)))
+	assert.Equal(t, 0, len(Scale{}.Triads()))
+}
+
+func TestHarmonize_Degrees(t *testing.T) {
+	for i, dc := range Of("G mixolydian").Triads() {
+		assert.Equal(t, Interval(i+1), dc.Degree)
+		assert.Equal(t, Of("G mixolydian").Tones[dc.Degree], dc.Chord.Root)
+		assert.Equal(t, 3, len(dc.Chord.Tones))
+		for _, interval := range []chord.Interval{chord.I1, chord.I3, chord.I5} {
+			_, ok := dc.Chord.Tones[interval]
+			assert.True(t, ok)
+		}
+	}
+}
+
+func TestHarmonize_AllModes(t *testing.T) {
+	for _, mode := range ScaleModeList {
+		s := Of("C " + mode)
+		for _, dc := range s.Sevenths() {
+			for _, class := range dc.Chord.Tones {
+				assert.True(t, containsClass(s, class), mode)
+			}
+		}
+		assert.Equal(t, len(s.Tones), len(s.Triads()), mode)
+	}
+}
+
+//
+// Private
+//
+
+func assertHarmony(t *testing.T, expect []string, actual []DegreeChord) {
+	var names []string
+	for _, dc := range actual {
+		names = append(names, dc.Chord.Name())
+	}
+	assert.Equal(t, expect, names)
+}
+
+func containsClass(s Scale, class note.Class) bool {
+	for _, c := range s.Tones {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
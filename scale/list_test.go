@@ -10,5 +10,5 @@ import (
 func TestListToYAML(t *testing.T) {
 	c := ScaleModeList
 	out := c.ToYAML()
-	assert.Equal(t, "- Default (Major)\n- Minor\n- Major\n- Natural Minor\n- Diminished\n- Augmented\n- Melodic Minor Ascend\n- Melodic Minor Descend\n- Harmonic Minor\n- Ionian\n- Dorian\n- Phrygian\n- Lydian\n- Mixolydian\n- Aeolian\n- Locrian\n", out)
+	assert.Equal(t, "- Default (Major)\n- Minor\n- Major\n- Natural Minor\n- Diminished\n- Augmented\n- Melodic Minor\n- Jazz Minor\n- Melodic Minor Ascend\n- Melodic Minor Descend\n- Harmonic Minor\n- Ionian\n- Dorian\n- Phrygian\n- Lydian\n- Mixolydian\n- Aeolian\n- Locrian\n- Dorian b2\n- Lydian Augmented\n- Lydian Dominant\n- Mixolydian b6\n- 'Locrian #2'\n- Altered\n- 'Locrian #6'\n- 'Ionian #5'\n- 'Dorian #4'\n- Phrygian Dominant\n- 'Lydian #2'\n- Ultralocrian\n- Harmonic Major\n- Dorian b5\n- Phrygian b4\n- Lydian b3\n- Mixolydian b2\n- 'Lydian Augmented #2'\n- Locrian bb7\n- Major Pentatonic\n- Minor Pentatonic\n- Blues\n- Major Blues\n- Whole Tone\n- Octatonic Whole-Half\n- Octatonic Half-Whole\n- Bebop Dominant\n- Bebop Dorian\n- Bebop Major\n- Raga Bhimpalasi\n- Raga Desh\n- Raga Khamaj\n- Hungarian Minor\n- Hungarian Major\n- Neapolitan Minor\n- Neapolitan Major\n- Double Harmonic\n- Hirajoshi\n- In\n- Iwato\n- Kumoi\n- Pelog\n", out)
 }
@@ -3,14 +3,18 @@ package scale
 
 import (
 	"regexp"
+	"strings"
+
+	"github.com/go-music-theory/music-theory/note"
 )
 
 // Mode is identified by positive/negative regular expressions, and then adds/removes pitch classes by interval from the root of the scale.
 type Mode struct {
-	Name string
-	pos  *regexp.Regexp
-	set  ModeIntervals
-	omit ModeOmit
+	Name    string
+	pos     *regexp.Regexp
+	set     ModeIntervals
+	omit    ModeOmit
+	descend ModeIntervals // Intervals of the scale descending, only if they differ from the set ascending
 }
 
 // ModeAdd maps an interval-from-scale-root to a +/1 semitone adjustment
@@ -31,15 +35,18 @@ func (this *Mode) MatchString(s string) bool {
 // Regular expression to use mid-word, gluing together mode expression parts
 var nExp = "[. ]*"
 
+// Regular expression to glue together hyphenated mode expression parts, e.g. "half-whole"
+var hyphenExp = "[. -]*"
+
 // Regular expressions for different utilities
 var (
 	majorExp       = "(M|maj|major)"
 	minorStrictExp = "([^a-z ]|^)(m|min|minor)"
 	minorExp       = "(m|min|minor)"
 
-	//flatExp  = "(f|flat|b|♭)"
-	//sharpExp = "(#|s|sharp)"
-	//halfExp  = "half"
+	flatExp  = "(f|flat|b|♭)"
+	sharpExp = "(#|s|sharp|♯)"
+	halfExp  = "half"
 
 	//omitExp = "(omit|\\-)"
 
@@ -50,7 +57,12 @@ var (
 	diminishedExp = "(dim|dimin|diminished)"
 	augmentedExp  = "(aug|augment|augmented)"
 	harmonicExp   = "(harm|harmonic)"
-	//dominantExp    = "(^|dom|dominant)"
+	dominantExp   = "(dom|dominant)"
+	pentatonicExp = "(pent|pentatonic)"
+	bebopExp      = "bebop"
+	hungarianExp  = "(hung|hungarian)"
+	neapolitanExp = "(neap|neapolitan)"
+	ragaExp       = "(raga[. ]*)?"
 	//nondominantExp = "(non|nondom|nondominant)"
 	//suspendedExp   = "(sus|susp|suspend|suspended)"
 
@@ -71,6 +83,12 @@ var (
 	locrianIntervals    = ModeIntervals{1, 2, 2, 1, 2, 2}
 )
 
+// Regular expression for strictly parsing the words and numbers of a mode name
+var modeTokenExp = regexp.MustCompile(`\pL+|[0-9]+`)
+
+// Regular expression for the words of a mode name that are matched regardless of case
+var modeWordExp = regexp.MustCompile(`[A-Za-z]{2,}`)
+
 // modes is an ordered set of rules to match, and corresponding scale intervals to setup.
 var modes = []Mode{
 
@@ -112,6 +130,19 @@ var modes = []Mode{
 		omit: ModeOmit{I7},
 	},
 
+	Mode{
+		Name:    "Melodic Minor",
+		pos:     exp(melodicExp + nExp + minorExp),
+		set:     ModeIntervals{2, 1, 2, 2, 2, 2},
+		descend: aeolianIntervals,
+	},
+
+	Mode{
+		Name: "Jazz Minor",
+		pos:  exp("jazz" + nExp + minorExp),
+		set:  ModeIntervals{2, 1, 2, 2, 2, 2},
+	},
+
 	Mode{
 		Name: "Melodic Minor Ascend",
 		pos:  exp(melodicExp + nExp + minorExp + nExp + ascendExp),
@@ -171,6 +202,287 @@ var modes = []Mode{
 		pos:  exp(locrianExp),
 		set:  locrianIntervals,
 	},
+
+	// Modes of Melodic Minor
+
+	Mode{
+		Name: "Dorian b2",
+		pos:  exp(dorianExp + nExp + flatExp + nExp + "2|" + phrygianExp + nExp + sharpExp + nExp + "6"),
+		set:  ModeIntervals{1, 2, 2, 2, 2, 1},
+	},
+
+	Mode{
+		Name: "Lydian Augmented",
+		pos:  exp(lydianExp + nExp + "(" + augmentedExp + "|" + sharpExp + nExp + "5)"),
+		set:  ModeIntervals{2, 2, 2, 2, 1, 2},
+	},
+
+	Mode{
+		Name: "Lydian Dominant",
+		pos:  exp(lydianExp + nExp + dominantExp + "|overtone|acoustic"),
+		set:  ModeIntervals{2, 2, 2, 1, 2, 1},
+	},
+
+	Mode{
+		Name: "Mixolydian b6",
+		pos:  exp(mixolydianExp + nExp + flatExp + nExp + "6|" + aeolianExp + nExp + dominantExp + "|" + melodicExp + nExp + majorExp),
+		set:  ModeIntervals{2, 2, 1, 2, 1, 2},
+	},
+
+	Mode{
+		Name: "Locrian #2",
+		pos:  exp(locrianExp + nExp + "(" + sharpExp + "|" + naturalExp + ")" + nExp + "2|" + halfExp + nExp + diminishedExp),
+		set:  ModeIntervals{2, 1, 2, 1, 2, 2},
+	},
+
+	Mode{
+		Name: "Altered",
+		pos:  exp("(alt|altered)|super" + nExp + locrianExp),
+		set:  ModeIntervals{1, 2, 1, 2, 2, 2},
+	},
+
+	// Modes of Harmonic Minor
+
+	Mode{
+		Name: "Locrian #6",
+		pos:  exp(locrianExp + nExp + sharpExp + nExp + "6"),
+		set:  ModeIntervals{1, 2, 2, 1, 3, 1},
+	},
+
+	Mode{
+		Name: "Ionian #5",
+		pos:  exp(ionianExp + nExp + "(" + augmentedExp + "|" + sharpExp + nExp + "5)"),
+		set:  ModeIntervals{2, 2, 1, 3, 1, 2},
+	},
+
+	Mode{
+		Name: "Dorian #4",
+		pos:  exp(dorianExp + nExp + sharpExp + nExp + "4|ukrainian" + nExp + dorianExp + "|romanian" + nExp + minorExp),
+		set:  ModeIntervals{2, 1, 3, 1, 2, 1},
+	},
+
+	Mode{
+		Name: "Phrygian Dominant",
+		pos:  exp(phrygianExp + nExp + "(" + dominantExp + "|" + sharpExp + nExp + "3)|spanish"),
+		set:  ModeIntervals{1, 3, 1, 2, 1, 2},
+	},
+
+	Mode{
+		Name: "Lydian #2",
+		pos:  exp(lydianExp + nExp + sharpExp + nExp + "2"),
+		set:  ModeIntervals{3, 1, 2, 1, 2, 2},
+	},
+
+	Mode{
+		Name: "Ultralocrian",
+		pos:  exp("ultra" + nExp + locrianExp),
+		set:  ModeIntervals{1, 2, 1, 2, 2, 1},
+	},
+
+	// Modes of Harmonic Major
+
+	Mode{
+		Name: "Harmonic Major",
+		pos:  exp(harmonicExp + nExp + majorExp),
+		set:  ModeIntervals{2, 2, 1, 2, 1, 3},
+	},
+
+	Mode{
+		Name: "Dorian b5",
+		pos:  exp(dorianExp + nExp + flatExp + nExp + "5"),
+		set:  ModeIntervals{2, 1, 2, 1, 3, 1},
+	},
+
+	Mode{
+		Name: "Phrygian b4",
+		pos:  exp(phrygianExp + nExp + flatExp + nExp + "4"),
+		set:  ModeIntervals{1, 2, 1, 3, 1, 2},
+	},
+
+	Mode{
+		Name: "Lydian b3",
+		pos:  exp(lydianExp + nExp + flatExp + nExp + "3"),
+		set:  ModeIntervals{2, 1, 3, 1, 2, 2},
+	},
+
+	Mode{
+		Name: "Mixolydian b2",
+		pos:  exp(mixolydianExp + nExp + flatExp + nExp + "2"),
+		set:  ModeIntervals{1, 3, 1, 2, 2, 1},
+	},
+
+	Mode{
+		Name: "Lydian Augmented #2",
+		pos:  exp(lydianExp + nExp + "(" + augmentedExp + "|" + sharpExp + nExp + "5)" + nExp + sharpExp + nExp + "2"),
+		set:  ModeIntervals{3, 1, 2, 2, 1, 2},
+	},
+
+	Mode{
+		Name: "Locrian bb7",
+		pos:  exp(locrianExp + nExp + "(bb|𝄫|" + flatExp + nExp + flatExp + ")" + nExp + "7"),
+		set:  ModeIntervals{1, 2, 2, 1, 2, 1},
+	},
+
+	// Pentatonic and Blues
+
+	Mode{
+		Name: "Major Pentatonic",
+		pos:  exp(pentatonicExp),
+		set:  ModeIntervals{2, 2, 3, 2},
+	},
+
+	Mode{
+		Name: "Minor Pentatonic",
+		pos:  exp(minorExp + nExp + pentatonicExp),
+		set:  ModeIntervals{3, 2, 2, 3},
+	},
+
+	Mode{
+		Name: "Blues",
+		pos:  exp("blues"),
+		set:  ModeIntervals{3, 2, 1, 1, 3},
+	},
+
+	Mode{
+		Name: "Major Blues",
+		pos:  exp(majorExp + nExp + "blues"),
+		set:  ModeIntervals{2, 1, 1, 3, 2},
+	},
+
+	// Symmetric
+
+	Mode{
+		Name: "Whole Tone",
+		pos:  exp("whole" + nExp + "tone"),
+		set:  ModeIntervals{2, 2, 2, 2, 2},
+	},
+
+	Mode{
+		Name: "Octatonic Whole-Half",
+		pos:  exp("(oct|octatonic)|whole" + hyphenExp + "half"),
+		set:  ModeIntervals{2, 1, 2, 1, 2, 1, 2},
+	},
+
+	Mode{
+		Name: "Octatonic Half-Whole",
+		pos:  exp(halfExp + hyphenExp + "whole|" + dominantExp + nExp + diminishedExp),
+		set:  ModeIntervals{1, 2, 1, 2, 1, 2, 1},
+	},
+
+	// Bebop, ascending by the parent mode and descending with a chromatic passing tone
+
+	Mode{
+		Name:    "Bebop Dominant",
+		pos:     exp(bebopExp + "(" + nExp + dominantExp + ")?"),
+		set:     mixolydianIntervals,
+		descend: ModeIntervals{2, 2, 1, 2, 2, 1, 1},
+	},
+
+	Mode{
+		Name:    "Bebop Dorian",
+		pos:     exp(bebopExp + nExp + "(" + dorianExp + "|" + minorExp + ")"),
+		set:     dorianIntervals,
+		descend: ModeIntervals{2, 1, 1, 1, 2, 2, 1},
+	},
+
+	Mode{
+		Name:    "Bebop Major",
+		pos:     exp(bebopExp + nExp + majorExp),
+		set:     ionianIntervals,
+		descend: ModeIntervals{2, 2, 1, 2, 1, 1, 2},
+	},
+
+	// Ragas, ascending (aroha) and descending (avaroha) by different tones
+
+	Mode{
+		Name:    "Raga Bhimpalasi",
+		pos:     exp(ragaExp + "bhimpalasi"),
+		set:     ModeIntervals{3, 2, 2, 3},
+		descend: dorianIntervals,
+	},
+
+	Mode{
+		Name:    "Raga Desh",
+		pos:     exp(ragaExp + "desh"),
+		set:     ModeIntervals{2, 3, 2, 4},
+		descend: mixolydianIntervals,
+	},
+
+	Mode{
+		Name:    "Raga Khamaj",
+		pos:     exp(ragaExp + "khamaj"),
+		set:     ModeIntervals{4, 1, 2, 2, 2},
+		descend: mixolydianIntervals,
+	},
+
+	// Hungarian, Neapolitan and Double Harmonic
+
+	Mode{
+		Name: "Hungarian Minor",
+		pos:  exp(hungarianExp + nExp + minorExp + "|gypsy"),
+		set:  ModeIntervals{2, 1, 3, 1, 1, 3},
+	},
+
+	Mode{
+		Name: "Hungarian Major",
+		pos:  exp(hungarianExp + nExp + majorExp),
+		set:  ModeIntervals{3, 1, 2, 1, 2, 1},
+	},
+
+	Mode{
+		Name: "Neapolitan Minor",
+		pos:  exp(neapolitanExp + nExp + minorExp),
+		set:  ModeIntervals{1, 2, 2, 2, 1, 3},
+	},
+
+	Mode{
+		Name: "Neapolitan Major",
+		pos:  exp(neapolitanExp + nExp + majorExp),
+		set:  ModeIntervals{1, 2, 2, 2, 2, 2},
+	},
+
+	Mode{
+		Name: "Double Harmonic",
+		pos:  exp("double" + nExp + harmonicExp + "|byzantine|arabic"),
+		set:  ModeIntervals{1, 3, 1, 2, 1, 3},
+	},
+
+	// World, approximated in twelve-tone equal temperament
+
+	Mode{
+		Name: "Hirajoshi",
+		pos:  exp("(hira|hirajoshi)"),
+		set:  ModeIntervals{2, 1, 4, 1},
+	},
+
+	Mode{
+		Name: "In",
+		pos:  exp(`\bin\b|miyako` + hyphenExp + "(bushi)?"),
+		set:  ModeIntervals{1, 4, 2, 1},
+	},
+
+	Mode{
+		Name: "Iwato",
+		pos:  exp("iwato"),
+		set:  ModeIntervals{1, 4, 1, 4},
+	},
+
+	Mode{
+		Name: "Kumoi",
+		pos:  exp("kumoi"),
+		set:  ModeIntervals{2, 1, 4, 2},
+	},
+
+	Mode{
+		Name: "Pelog",
+		pos:  exp("pelog"),
+		set:  ModeIntervals{1, 2, 4, 1},
+	},
+}
+
+// lowerWords of a mode name, so that e.g. "Lydian Dominant" matches the same as "lydian dominant", while a single letter keeps its case, e.g. "M" (major) or "m" (minor)
+func lowerWords(name string) string {
+	return modeWordExp.ReplaceAllStringFunc(name, strings.ToLower)
 }
 
 func exp(s string) *regexp.Regexp {
@@ -195,12 +507,38 @@ func (this *Mode) matchPosNegString(s string) bool {
 	}
 }
 
-// Build the scale by processing all Modes against the given name.
+// unknownModeOffset is the byte offset of the first word or number in the given mode name that is not matched by any Mode, or -1 if the whole name is understood.
+func unknownModeOffset(name string) int {
+	matched := make([]bool, len(name))
+	for _, m := range modes {
+		if m.pos == nil {
+			continue
+		}
+		for _, loc := range m.pos.FindAllStringIndex(name, -1) {
+			for i := loc[0]; i < loc[1]; i++ {
+				matched[i] = true
+			}
+		}
+	}
+
+	for _, loc := range modeTokenExp.FindAllStringIndex(name, -1) {
+		anyMatched := false
+		for i := loc[0]; i < loc[1]; i++ {
+			anyMatched = anyMatched || matched[i]
+		}
+		if !anyMatched {
+			return loc[0]
+		}
+	}
+	return -1
+}
+
+// Build the scale by processing all Modes against the given name; each matching Mode rebuilds the scale, so the last (most specific) one determines its tones, e.g. "C lydian dominant" matches Lydian and then Lydian Dominant.
 func (this *Scale) parseModes(name string) {
 	var toDelete []Interval
 	for _, f := range modes {
 		if f.MatchString(name) {
-			toDelete = append(toDelete, this.applyMode(f)...)
+			toDelete = this.applyMode(f)
 		}
 	}
 	for _, t := range toDelete {
@@ -210,14 +548,24 @@ func (this *Scale) parseModes(name string) {
 }
 
 func (this *Scale) applyMode(f Mode) (toDelete []Interval) {
-	ct := I1
-	this.Tones[ct] = this.Root
-	for _, c := range f.set {
-		ct++
-		this.Tones[ct], _ = this.Tones[ct-1].Step(c)
+	this.Tones = tonesOf(this.Root, f.set)
+	this.Descend = nil
+	if f.descend != nil {
+		this.Descend = tonesOf(this.Root, f.descend)
 	}
 	for _, t := range f.omit {
 		toDelete = append(toDelete, t)
 	}
 	return
 }
+
+// tonesOf the intervals stepped from the root
+func tonesOf(root note.Class, set ModeIntervals) map[Interval]note.Class {
+	tones := map[Interval]note.Class{I1: root}
+	ct := I1
+	for _, c := range set {
+		ct++
+		tones[ct], _ = tones[ct-1].Step(c)
+	}
+	return tones
+}
@@ -29,6 +29,47 @@ func TestScaleParseModes(t *testing.T) {
 	}, c.Tones)
 }
 
+func TestScaleParseModes_Specific(t *testing.T) {
+	assert.Equal(t, Of("C lydian dominant").Tones, Of("C Lydian Dominant").Tones)
+	assert.Equal(t, Of("C lydian dominant").Tones, Of("C overtone").Tones)
+	assert.Equal(t, Of("C altered").Tones, Of("C super locrian").Tones)
+	assert.Equal(t, Of("C locrian #2").Tones, Of("C half diminished").Tones)
+	assert.Equal(t, Of("C octatonic half-whole").Tones, Of("C dominant diminished").Tones)
+	assert.Equal(t, Of("C blues").Tones, Of("C minor blues").Tones)
+	assert.Equal(t, Of("C major pentatonic").Tones, Of("C pentatonic").Tones)
+	assert.Equal(t, Of("C in").Tones, Of("C miyako-bushi").Tones)
+	assert.Equal(t, map[Interval]note.Class{
+		I1: note.C,
+		I2: note.Ds,
+		I3: note.F,
+		I4: note.G,
+		I5: note.As,
+	}, Of("C minor pentatonic").Tones)
+	assert.Equal(t, map[Interval]note.Class{
+		I1: note.C,
+		I2: note.D,
+		I3: note.E,
+		I4: note.F,
+		I5: note.G,
+		I6: note.A,
+		I7: note.As,
+	}, Of("C mixolydian").Tones)
+	assert.Equal(t, Of("Cm").Tones, Of("C Minor").Tones)
+	assert.NotEqual(t, Of("Cm").Tones, Of("CM").Tones)
+}
+
+func TestScaleParseModes_AllNamed(t *testing.T) {
+	for _, m := range modes {
+		if m.pos == nil {
+			continue
+		}
+		s, err := Parse("C " + m.Name)
+		assert.Nil(t, err, m.Name)
+		assert.Equal(t, Of("C").Root, s.Root, m.Name)
+		assert.Equal(t, m.scaleOf(note.C, s.AdjSymbol).Tones, s.Tones, m.Name)
+	}
+}
+
 //
 // Private
 //
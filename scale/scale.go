@@ -22,7 +22,8 @@ import (
 type Scale struct {
 	Root      note.Class
 	AdjSymbol note.AdjSymbol
-	Tones     map[Interval]note.Class
+	Tones     map[Interval]note.Class // Tones of the scale, ascending
+	Descend   map[Interval]note.Class // Tones of the scale descending, only if they differ from ascending, e.g. the natural minor of a melodic minor scale
 }
 
 // Of a particular key, e.g. Of("C minor 7")
@@ -32,6 +33,15 @@ func Of(name string) Scale {
 	return c
 }
 
+// Parse a scale strictly, e.g. Parse("D dorian"), returning a *note.ParseError if the root, accidental or mode is not understood
+func Parse(name string) (Scale, error) {
+	c := Scale{}
+	if err := c.parse(name); err != nil {
+		return Scale{}, err
+	}
+	return c, nil
+}
+
 // Notes to obtain the notes from the Scale
 func (this *Scale) Notes() (notes []*note.Note) {
 	forAllIn(this.Tones, func(class note.Class) {
@@ -40,19 +50,71 @@ func (this *Scale) Notes() (notes []*note.Note) {
 	return
 }
 
+// Spelled tones of the Scale, in which each degree of a seven-tone scale uses a distinct letter name, e.g. C# major is C# D# E# F# G# A# B#
+// Scales with more or fewer than seven tones are spelled with the Scale's Sharps or Flats.
+func (this Scale) Spelled() map[Interval]note.Spelled {
+	spelled := make(map[Interval]note.Spelled)
+	root := this.Root.Spelled(this.AdjSymbol)
+	heptatonic := isHeptatonic(this.Tones)
+	for i, class := range this.Tones {
+		if heptatonic {
+			spelled[i] = class.SpelledAs(root.Letter.Step(int(i) - 1))
+		} else {
+			spelled[i] = class.Spelled(this.AdjSymbol)
+		}
+	}
+	return spelled
+}
+
+// Intervals of each tone above the root of the Scale, e.g. D dorian is P1 M2 m3 P4 P5 M6 m7
+func (this Scale) Intervals() map[Interval]note.Interval {
+	intervals := make(map[Interval]note.Interval)
+	root := this.Root.Spelled(this.AdjSymbol)
+	for i, s := range this.Spelled() {
+		intervals[i] = note.IntervalBetween(root, s)
+	}
+	return intervals
+}
+
 //
 // Private
 //
 
-func (this *Scale) parse(name string) {
+// isHeptatonic is true for a scale with exactly seven tones, one on each of the degrees 1-7
+func isHeptatonic(tones map[Interval]note.Class) bool {
+	if len(tones) != 7 {
+		return false
+	}
+	for i := I1; i <= I7; i++ {
+		if _, ok := tones[i]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// parse the scale from its name, building as much of the scale as possible, and returning a *note.ParseError for the first part of the name that is not understood.
+func (this *Scale) parse(name string) error {
 	this.Tones = make(map[Interval]note.Class)
+	fullName := name
 
 	// determine whether the name is "sharps" or "flats"
 	this.AdjSymbol = note.AdjSymbolOf(name)
 
 	// parse the root, and keep the remaining string
+	_, modeOffset, rootErr := note.ParseRoot(name)
 	this.Root, name = note.RootAndRemaining(name)
+	name = lowerWords(name)
 
 	// parse the scale Mode
 	this.parseModes(name)
+
+	// report the first part of the name that is not understood
+	if rootErr != nil {
+		return rootErr
+	}
+	if offset := unknownModeOffset(name); offset >= 0 {
+		return &note.ParseError{Name: fullName, Part: note.ModePart, Offset: modeOffset + offset}
+	}
+	return nil
 }
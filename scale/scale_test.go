@@ -7,7 +7,6 @@ import (
 	"gopkg.in/stretchr/testify.v1/assert"
 
 	"fmt"
-	"github.com/go-music-theory/music-theory/key"
 	"github.com/go-music-theory/music-theory/note"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
@@ -31,6 +30,10 @@ func TestScaleExpectations(t *testing.T) {
 		for i, c := range actual.Tones {
 			assert.Equal(t, expect.Tones[i], c.String(actual.AdjSymbol), fmt.Sprintf("name:%v actual.Tones[%v]:%v expect.Tones[%v]:%v actual.AdjSymbol:%v", name, i, c.String(actual.AdjSymbol), i, expect.Tones[i], actual.AdjSymbol))
 		}
+		assert.Equal(t, len(expect.Descend), len(actual.Descend), fmt.Sprintf("name:%v expect.Descend:%v actual.Descend:%v", name, expect.Descend, actual.Descend))
+		for i, c := range expect.Descend {
+			assert.Equal(t, c, actual.Descend[i].String(actual.AdjSymbol), fmt.Sprintf("name:%v expect.Descend[%v]:%v actual.Descend[%v]:%v actual.AdjSymbol:%v", name, i, c, i, actual.Descend[i].String(actual.AdjSymbol), actual.AdjSymbol))
+		}
 	}
 }
 
@@ -47,18 +50,71 @@ func TestNotes(t *testing.T) {
 	}, c.Notes())
 }
 
+func TestParse(t *testing.T) {
+	s, err := Parse("D dorian")
+	assert.Nil(t, err)
+	assert.Equal(t, Of("D dorian"), s)
+
+	s, err = Parse("C melodic minor ascend")
+	assert.Nil(t, err)
+	assert.Equal(t, Of("C melodic minor ascend"), s)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	assertParseError(t, "", note.RootPart, 0)
+	assertParseError(t, "H minor", note.RootPart, 0)
+	assertParseError(t, "C blorp", note.ModePart, 2)
+	assertParseError(t, "C major 7", note.ModePart, 8)
+}
+
+func TestSpelled(t *testing.T) {
+	assertSpelled(t, "C# major", "C#", "D#", "E#", "F#", "G#", "A#", "B#")
+	assertSpelled(t, "Ab minor", "Ab", "Bb", "Cb", "Db", "Eb", "Fb", "Gb")
+	assertSpelled(t, "F major", "F", "G", "A", "Bb", "C", "D", "E")
+	assertSpelled(t, "C aug", "C", "D#", "E", "G", "G#", "B")
+}
+
+func TestIntervals(t *testing.T) {
+	intervals := Of("D dorian").Intervals()
+	var actual []string
+	for _, i := range intervalOrder {
+		if interval, ok := intervals[i]; ok {
+			actual = append(actual, interval.String())
+		}
+	}
+	assert.Equal(t, []string{"P1", "M2", "m3", "P4", "P5", "M6", "m7"}, actual)
+}
+
 func TestOf_Invalid(t *testing.T) {
-	k := key.Of("P-funk")
-	assert.Equal(t, note.Nil, k.Root)
+	s := Of("P-funk")
+	assert.Equal(t, note.Nil, s.Root)
 }
 
 //
 // Private
 //
 
+func assertSpelled(t *testing.T, name string, expectTones ...string) {
+	spelled := Of(name).Spelled()
+	var actualTones []string
+	for _, i := range intervalOrder {
+		if s, ok := spelled[i]; ok {
+			actualTones = append(actualTones, s.String())
+		}
+	}
+	assert.Equal(t, expectTones, actualTones, name)
+}
+
+func assertParseError(t *testing.T, name string, expectPart note.NamePart, expectOffset int) {
+	s, err := Parse(name)
+	assert.Equal(t, &note.ParseError{Name: name, Part: expectPart, Offset: expectOffset}, err, name)
+	assert.Equal(t, Scale{}, s)
+}
+
 type testKey struct {
-	Root  string
-	Tones map[Interval]string
+	Root    string
+	Tones   map[Interval]string
+	Descend map[Interval]string
 }
 
 type testExpectationManifest struct {
@@ -17,15 +17,22 @@ func (c Scale) ToYAML() string {
 
 func specFrom(c Scale) specScale {
 	s := specScale{}
-	s.Root = c.Root.String(c.AdjSymbol)
+	s.Root = c.Root.Spelled(c.AdjSymbol).String()
 	s.Tones = make(map[int]string)
-	for i, t := range c.Tones {
-		s.Tones[int(i)] = t.String(c.AdjSymbol)
+	for i, t := range c.Spelled() {
+		s.Tones[int(i)] = t.String()
+	}
+	if c.Descend != nil {
+		s.Descend = make(map[int]string)
+		for i, t := range c.inDirection(DescendDirection).Spelled() {
+			s.Descend[int(i)] = t.String()
+		}
 	}
 	return s
 }
 
 type specScale struct {
-	Root  string
-	Tones map[int]string
+	Root    string
+	Tones   map[int]string
+	Descend map[int]string `yaml:",omitempty"`
 }
@@ -12,3 +12,9 @@ func TestToYAML(t *testing.T) {
 	out := c.ToYAML()
 	assert.Equal(t, "root: C\ntones:\n  1: C\n  2: D\n  3: Eb\n  4: F\n  5: G\n  6: Ab\n  7: Bb\n", out)
 }
+
+func TestToYAML_Spelled(t *testing.T) {
+	c := Of("C# major")
+	out := c.ToYAML()
+	assert.Equal(t, "root: C#\ntones:\n  1: C#\n  2: D#\n  3: E#\n  4: F#\n  5: G#\n  6: A#\n  7: B#\n", out)
+}